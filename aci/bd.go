@@ -355,3 +355,29 @@ func (c *Client) BridgeDomainSetUnicastRouting(tenant, bd string, enabled bool)
 
 	return parseJSONError(body)
 }
+
+// BridgeDomainDHCPLabelAdd attaches a DHCP relay policy, by label, to a bridge domain.
+// label is the relay policy name. owner: DHCPOwnerTenant, DHCPOwnerInfra
+// optionPolicy is the DHCP option policy name. Empty means no option policy.
+func (c *Client) BridgeDomainDHCPLabelAdd(tenant, bd, label, owner, optionPolicy string) error {
+
+	var children string
+	if optionPolicy != "" {
+		children = fmt.Sprintf(`{"dhcpRsDhcpOptionPol":{"attributes":{"tnDhcpOptionPolName":"%s","status":"created,modified"}}}`, optionPolicy)
+	}
+
+	j := fmt.Sprintf(`{"dhcpLbl":{"attributes":{"name":"%s","owner":"%s","status":"created,modified"},"children":[%s]}}`,
+		label, owner, children)
+
+	return c.moPost("BridgeDomainDHCPLabelAdd", dnBridgeDomain(tenant, bd), j)
+}
+
+// BridgeDomainDHCPLabelDel detaches a DHCP relay label from a bridge domain.
+func (c *Client) BridgeDomainDHCPLabelDel(tenant, bd, label string) error {
+	return c.moChildDel("BridgeDomainDHCPLabelDel", "fvBD", dnBridgeDomain(tenant, bd), "dhcpLbl", "dhcplbl-"+label)
+}
+
+// BridgeDomainDHCPLabelList retrieves the list of DHCP relay labels attached to a bridge domain.
+func (c *Client) BridgeDomainDHCPLabelList(tenant, bd string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("BridgeDomainDHCPLabelList", dnBridgeDomain(tenant, bd), "dhcpLbl")
+}
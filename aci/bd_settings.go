@@ -0,0 +1,229 @@
+package aci
+
+import (
+	"fmt"
+	"strings"
+)
+
+// L2 unknown unicast actions for BridgeDomainUnknownUnicastSet.
+const (
+	BDUnknownUnicastProxy = "proxy" // Hardware proxy.
+	BDUnknownUnicastFlood = "flood"
+)
+
+// L3 unknown multicast actions for BridgeDomainUnknownMulticastSet.
+const (
+	BDUnknownMulticastFlood     = "flood"
+	BDUnknownMulticastOptimized = "opt-flood" // Optimized flood.
+)
+
+// Multi-destination flooding actions for BridgeDomainMultiDestinationSet.
+const (
+	BDMultiDestinationFlood      = "bd-flood"    // Flood in BD.
+	BDMultiDestinationEncapFlood = "encap-flood" // Flood in encapsulation.
+	BDMultiDestinationDrop       = "drop"
+)
+
+// Subnet control flags for BridgeDomainSubnetControlSet.
+const (
+	SubnetCtrlQuerier          = "querier"            // IGMP querier.
+	SubnetCtrlNoDefaultGateway = "no-default-gateway" // No default SVI gateway.
+	SubnetCtrlND               = "nd"                 // ND RA prefix.
+)
+
+// BridgeDomain holds the forwarding settings of a bridge domain.
+type BridgeDomain struct {
+	Dn                 string
+	Name               string
+	Descr              string
+	Vrf                string
+	UnicastRouting     bool
+	ARPFlood           bool
+	UnknownUnicast     string // BDUnknownUnicastProxy, BDUnknownUnicastFlood
+	UnknownMulticast   string // BDUnknownMulticastFlood, BDUnknownMulticastOptimized
+	MultiDestination   string // BDMultiDestinationFlood, BDMultiDestinationEncapFlood, BDMultiDestinationDrop
+	LimitIPLearning    bool   // Limit IP learning to subnet.
+	EndpointMoveDetect bool   // Endpoint move detection by GARP.
+	MAC                string // Example: "00:22:BD:F8:19:FF"
+}
+
+// BridgeDomainSubnet holds the settings of a bridge domain subnet.
+type BridgeDomainSubnet struct {
+	Dn        string
+	IP        string // Example: "10.0.0.1/24"
+	Descr     string
+	Scope     []string // Example: []string{"public", "shared"}
+	Preferred bool     // Primary IP address of the BD.
+	Virtual   bool     // Virtual IP address.
+	Ctrl      []string // SubnetCtrl* flags.
+}
+
+func bridgeDomainFromObject(obj imdataObject) BridgeDomain {
+
+	bd := BridgeDomain{
+		Dn:                 mapString(obj.attr, "dn"),
+		Name:               mapString(obj.attr, "name"),
+		Descr:              mapString(obj.attr, "descr"),
+		UnicastRouting:     mapString(obj.attr, "unicastRoute") == "yes",
+		ARPFlood:           mapString(obj.attr, "arpFlood") == "yes",
+		UnknownUnicast:     mapString(obj.attr, "unkMacUcastAct"),
+		UnknownMulticast:   mapString(obj.attr, "unkMcastAct"),
+		MultiDestination:   mapString(obj.attr, "multiDstPktAct"),
+		LimitIPLearning:    mapString(obj.attr, "limitIpLearnToSubnets") == "yes",
+		EndpointMoveDetect: mapString(obj.attr, "epMoveDetectMode") == "garp",
+		MAC:                mapString(obj.attr, "mac"),
+	}
+
+	for _, ctx := range obj.childrenByClass("fvRsCtx") {
+		bd.Vrf = mapString(ctx.attr, "tnFvCtxName")
+	}
+
+	return bd
+}
+
+func bridgeDomainSubnetFromAttributes(attr map[string]interface{}) BridgeDomainSubnet {
+	return BridgeDomainSubnet{
+		Dn:        mapString(attr, "dn"),
+		IP:        mapString(attr, "ip"),
+		Descr:     mapString(attr, "descr"),
+		Scope:     splitFlags(mapString(attr, "scope")),
+		Preferred: mapString(attr, "preferred") == "yes",
+		Virtual:   mapString(attr, "virtual") == "yes",
+		Ctrl:      splitFlags(mapString(attr, "ctrl")),
+	}
+}
+
+// BridgeDomainGet retrieves the forwarding settings of a bridge domain.
+func (c *Client) BridgeDomainGet(tenant, bd string) (BridgeDomain, error) {
+
+	me := "BridgeDomainGet"
+
+	key := "fvBD"
+
+	dn := dnBridgeDomain(tenant, bd)
+
+	api := "/api/node/mo/uni/" + dn + ".json?rsp-subtree=children&rsp-subtree-class=fvRsCtx"
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return BridgeDomain{}, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	objs, errObj := jsonImdataObjects(c, body, me)
+	if errObj != nil {
+		return BridgeDomain{}, errObj
+	}
+
+	if len(objs) != 1 || objs[0].class != key {
+		return BridgeDomain{}, fmt.Errorf("%s: bad object count=%d", me, len(objs))
+	}
+
+	return bridgeDomainFromObject(objs[0]), nil
+}
+
+// bridgeDomainAttrSet modifies an attribute of an existing bridge domain.
+func (c *Client) bridgeDomainAttrSet(me, tenant, bd, attr, value string) error {
+
+	dn := dnBridgeDomain(tenant, bd)
+
+	j := fmt.Sprintf(`{"fvBD":{"attributes":{"dn":"uni/%s","%s":"%s","status":"modified"}}}`,
+		dn, attr, value)
+
+	return c.moPost(me, dn, j)
+}
+
+// BridgeDomainARPFloodSet enables/disables ARP flooding for the bridge domain.
+func (c *Client) BridgeDomainARPFloodSet(tenant, bd string, enable bool) error {
+	return c.bridgeDomainAttrSet("BridgeDomainARPFloodSet", tenant, bd, "arpFlood", yesNo(enable))
+}
+
+// BridgeDomainUnknownUnicastSet sets the L2 unknown unicast action for the bridge domain.
+// action: BDUnknownUnicastProxy, BDUnknownUnicastFlood
+func (c *Client) BridgeDomainUnknownUnicastSet(tenant, bd, action string) error {
+	return c.bridgeDomainAttrSet("BridgeDomainUnknownUnicastSet", tenant, bd, "unkMacUcastAct", action)
+}
+
+// BridgeDomainUnknownMulticastSet sets the L3 unknown multicast flooding action for the bridge domain.
+// action: BDUnknownMulticastFlood, BDUnknownMulticastOptimized
+func (c *Client) BridgeDomainUnknownMulticastSet(tenant, bd, action string) error {
+	return c.bridgeDomainAttrSet("BridgeDomainUnknownMulticastSet", tenant, bd, "unkMcastAct", action)
+}
+
+// BridgeDomainMultiDestinationSet sets the multi-destination flooding action for the bridge domain.
+// action: BDMultiDestinationFlood, BDMultiDestinationEncapFlood, BDMultiDestinationDrop
+func (c *Client) BridgeDomainMultiDestinationSet(tenant, bd, action string) error {
+	return c.bridgeDomainAttrSet("BridgeDomainMultiDestinationSet", tenant, bd, "multiDstPktAct", action)
+}
+
+// BridgeDomainLimitIPLearningSet enables/disables limiting IP learning to the bridge domain subnets.
+func (c *Client) BridgeDomainLimitIPLearningSet(tenant, bd string, enable bool) error {
+	return c.bridgeDomainAttrSet("BridgeDomainLimitIPLearningSet", tenant, bd, "limitIpLearnToSubnets", yesNo(enable))
+}
+
+// BridgeDomainEndpointMoveDetectSet enables/disables endpoint move detection by GARP for the bridge domain.
+func (c *Client) BridgeDomainEndpointMoveDetectSet(tenant, bd string, enable bool) error {
+	var mode string
+	if enable {
+		mode = "garp"
+	}
+	return c.bridgeDomainAttrSet("BridgeDomainEndpointMoveDetectSet", tenant, bd, "epMoveDetectMode", mode)
+}
+
+// BridgeDomainMACSet sets the MAC address for the bridge domain gateway. Example: mac="00:22:BD:F8:19:FF"
+func (c *Client) BridgeDomainMACSet(tenant, bd, mac string) error {
+	return c.bridgeDomainAttrSet("BridgeDomainMACSet", tenant, bd, "mac", mac)
+}
+
+// BridgeDomainSubnetOptionsGet retrieves the settings of a bridge domain subnet.
+func (c *Client) BridgeDomainSubnetOptionsGet(tenant, bd, subnet string) (BridgeDomainSubnet, error) {
+
+	me := "BridgeDomainSubnetOptionsGet"
+
+	list, errSubnet := c.BridgeDomainSubnetGet(tenant, bd, subnet)
+	if errSubnet != nil {
+		return BridgeDomainSubnet{}, fmt.Errorf("%s: %v", me, errSubnet)
+	}
+
+	if len(list) < 1 {
+		return BridgeDomainSubnet{}, fmt.Errorf("%s: empty list of subnets", me)
+	}
+
+	return bridgeDomainSubnetFromAttributes(list[0]), nil
+}
+
+// bridgeDomainSubnetAttrSet modifies an attribute of an existing bridge domain subnet.
+func (c *Client) bridgeDomainSubnetAttrSet(me, tenant, bd, subnet, attr, value string) error {
+
+	dn := dnSubnet(tenant, bd, subnet)
+
+	j := fmt.Sprintf(`{"fvSubnet":{"attributes":{"dn":"uni/%s","%s":"%s","status":"modified"}}}`,
+		dn, attr, value)
+
+	return c.moPost(me, dn, j)
+}
+
+// BridgeDomainSubnetPreferredSet marks/unmarks the subnet as the primary (preferred) IP address of the bridge domain.
+func (c *Client) BridgeDomainSubnetPreferredSet(tenant, bd, subnet string, preferred bool) error {
+	return c.bridgeDomainSubnetAttrSet("BridgeDomainSubnetPreferredSet", tenant, bd, subnet, "preferred", yesNo(preferred))
+}
+
+// BridgeDomainSubnetVirtualSet marks/unmarks the subnet as a virtual IP address.
+func (c *Client) BridgeDomainSubnetVirtualSet(tenant, bd, subnet string, virtual bool) error {
+	return c.bridgeDomainSubnetAttrSet("BridgeDomainSubnetVirtualSet", tenant, bd, subnet, "virtual", yesNo(virtual))
+}
+
+// BridgeDomainSubnetControlSet sets the control flags for the subnet.
+// ctrl: list of SubnetCtrl* flags. Empty list clears all flags.
+func (c *Client) BridgeDomainSubnetControlSet(tenant, bd, subnet string, ctrl []string) error {
+	value := strings.Join(ctrl, ",")
+	if value == "" {
+		value = "unspecified"
+	}
+	return c.bridgeDomainSubnetAttrSet("BridgeDomainSubnetControlSet", tenant, bd, subnet, "ctrl", value)
+}
@@ -0,0 +1,56 @@
+package aci
+
+import (
+	"testing"
+)
+
+func TestBridgeDomainFromObject(t *testing.T) {
+
+	body := `{"totalCount":"2","imdata":[
+{"fvBD":{"attributes":{"dn":"uni/tn-prod/BD-bd1","name":"bd1","descr":"web","unicastRoute":"yes","arpFlood":"no","unkMacUcastAct":"proxy","unkMcastAct":"opt-flood","multiDstPktAct":"bd-flood","limitIpLearnToSubnets":"yes","epMoveDetectMode":"garp","mac":"00:22:BD:F8:19:FF"},"children":[
+	{"fvRsCtx":{"attributes":{"rn":"rsctx","tnFvCtxName":"v1"}}}]}},
+{"fvBD":{"attributes":{"dn":"uni/tn-prod/BD-bd2","name":"bd2","unicastRoute":"no","arpFlood":"yes","unkMacUcastAct":"flood","unkMcastAct":"flood","multiDstPktAct":"drop","limitIpLearnToSubnets":"no","epMoveDetectMode":""}}}]}`
+
+	objs, errObj := jsonImdataObjects(testDebug{t}, []byte(body), "TestBridgeDomainFromObject")
+	if errObj != nil {
+		t.Fatalf("parse error: %v", errObj)
+	}
+	if len(objs) != 2 {
+		t.Fatalf("want 2 objects, got %d", len(objs))
+	}
+
+	bd := bridgeDomainFromObject(objs[0])
+
+	if bd.Dn != "uni/tn-prod/BD-bd1" || bd.Name != "bd1" || bd.Descr != "web" || bd.MAC != "00:22:BD:F8:19:FF" {
+		t.Errorf("unexpected identity: %+v", bd)
+	}
+	if bd.Vrf != "v1" {
+		t.Errorf("want vrf=v1 got=%s", bd.Vrf)
+	}
+	if !bd.UnicastRouting || bd.ARPFlood || !bd.LimitIPLearning || !bd.EndpointMoveDetect {
+		t.Errorf("unexpected flags: %+v", bd)
+	}
+	if bd.UnknownUnicast != BDUnknownUnicastProxy || bd.UnknownMulticast != BDUnknownMulticastOptimized || bd.MultiDestination != BDMultiDestinationFlood {
+		t.Errorf("unexpected forwarding: %+v", bd)
+	}
+
+	bd = bridgeDomainFromObject(objs[1])
+
+	if bd.Vrf != "" {
+		t.Errorf("want empty vrf got=%s", bd.Vrf)
+	}
+	if bd.UnicastRouting || !bd.ARPFlood || bd.LimitIPLearning || bd.EndpointMoveDetect {
+		t.Errorf("unexpected flags: %+v", bd)
+	}
+	if bd.UnknownUnicast != BDUnknownUnicastFlood || bd.UnknownMulticast != BDUnknownMulticastFlood || bd.MultiDestination != BDMultiDestinationDrop {
+		t.Errorf("unexpected forwarding: %+v", bd)
+	}
+}
+
+func TestBridgeDomainSubnetFromAttributes(t *testing.T) {
+	attr := map[string]interface{}{"ip": "10.0.0.1/24", "scope": "public,shared", "preferred": "yes", "virtual": "no", "ctrl": "querier"}
+	s := bridgeDomainSubnetFromAttributes(attr)
+	if s.IP != "10.0.0.1/24" || len(s.Scope) != 2 || !s.Preferred || s.Virtual || len(s.Ctrl) != 1 {
+		t.Errorf("unexpected subnet: %+v", s)
+	}
+}
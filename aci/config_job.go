@@ -0,0 +1,133 @@
+package aci
+
+import (
+	"fmt"
+	"time"
+)
+
+// configJobPollInterval is the period between queries while waiting for a configuration job.
+const configJobPollInterval = 2 * time.Second
+
+// ConfigJob holds the status of a configuration import/export job (configJob).
+type ConfigJob struct {
+	Dn            string // Job DN. Example: "uni/backupst/jobs-[uni/fabric/configimp-imp1]/run-2017-05-12T10-04-33"
+	OperSt        string // Operational state: "pending", "running", "success", "partial-success", "failed", "fail-privilege"
+	Details       string // Details reported by APIC, including the failure reason, if any.
+	ExecuteTime   string // Time the job was executed.
+	FileName      string // Name of the configuration file.
+	FileSize      string // Size of the configuration file.
+	LastStepDescr string // Description of the last step performed by the job.
+}
+
+// Done reports whether the job has reached a terminal state.
+func (j ConfigJob) Done() bool {
+	switch j.OperSt {
+	case "", "pending", "running", "queued":
+		return false
+	}
+	return true
+}
+
+// Success reports whether the job has finished successfully.
+func (j ConfigJob) Success() bool {
+	return j.OperSt == "success"
+}
+
+func configJobFromAttributes(attr map[string]interface{}) ConfigJob {
+	return ConfigJob{
+		Dn:            mapString(attr, "dn"),
+		OperSt:        mapString(attr, "operSt"),
+		Details:       mapString(attr, "details"),
+		ExecuteTime:   mapString(attr, "executeTime"),
+		FileName:      mapString(attr, "fileName"),
+		FileSize:      mapString(attr, "fileSize"),
+		LastStepDescr: mapString(attr, "lastStepDescr"),
+	}
+}
+
+// dnConfigJobCont: "fabric/configimp-imp1" => "backupst/jobs-[uni/fabric/configimp-imp1]"
+func dnConfigJobCont(policyDn string) string {
+	return "backupst/jobs-[uni/" + policyDn + "]"
+}
+
+// configJobList retrieves the jobs created for a configuration import/export policy.
+func (c *Client) configJobList(me, policyDn string) ([]ConfigJob, error) {
+
+	key := "configJob"
+
+	dn := dnConfigJobCont(policyDn)
+
+	api := "/api/node/mo/uni/" + dn + ".json?query-target=children&target-subtree-class=" + key
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	attrs, errAttr := jsonImdataAttributes(c, body, key, me)
+	if errAttr != nil {
+		return nil, fmt.Errorf("%s: %v", me, errAttr)
+	}
+
+	jobs := make([]ConfigJob, 0, len(attrs))
+	for _, attr := range attrs {
+		jobs = append(jobs, configJobFromAttributes(attr))
+	}
+
+	return jobs, nil
+}
+
+// configJobKnown records the jobs already existing before a policy is triggered.
+func configJobKnown(jobs []ConfigJob) map[string]bool {
+	known := map[string]bool{}
+	for _, j := range jobs {
+		known[j.Dn] = true
+	}
+	return known
+}
+
+// configJobFindNew finds the first job not present in the known set.
+func configJobFindNew(known map[string]bool, jobs []ConfigJob) (ConfigJob, bool) {
+	for _, j := range jobs {
+		if !known[j.Dn] {
+			return j, true
+		}
+	}
+	return ConfigJob{}, false
+}
+
+// configJobWait polls the jobs for a policy until a job not present in the known set reaches a terminal state.
+func (c *Client) configJobWait(me, policyDn string, known map[string]bool, timeout time.Duration) (ConfigJob, error) {
+
+	deadline := time.Now().Add(timeout)
+
+	for {
+		jobs, errList := c.configJobList(me, policyDn)
+		if errList != nil {
+			return ConfigJob{}, errList
+		}
+
+		job, found := configJobFindNew(known, jobs)
+		if found {
+			c.debugf("%s: job=%s operSt=%s", me, job.Dn, job.OperSt)
+			if job.Done() {
+				return job, nil
+			}
+		}
+
+		if time.Now().After(deadline) {
+			if found {
+				return job, fmt.Errorf("%s: timeout waiting for job=%s operSt=%s", me, job.Dn, job.OperSt)
+			}
+			return ConfigJob{}, fmt.Errorf("%s: timeout waiting for job creation: policy=%s", me, policyDn)
+		}
+
+		time.Sleep(configJobPollInterval)
+	}
+}
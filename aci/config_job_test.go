@@ -0,0 +1,47 @@
+package aci
+
+import (
+	"testing"
+)
+
+func TestConfigJobDone(t *testing.T) {
+	configJobDoneTest(t, "", false)
+	configJobDoneTest(t, "pending", false)
+	configJobDoneTest(t, "running", false)
+	configJobDoneTest(t, "success", true)
+	configJobDoneTest(t, "partial-success", true)
+	configJobDoneTest(t, "failed", true)
+	configJobDoneTest(t, "fail-privilege", true)
+}
+
+func configJobDoneTest(t *testing.T, operSt string, want bool) {
+	j := ConfigJob{OperSt: operSt}
+	if got := j.Done(); got != want {
+		t.Errorf("operSt=%s want=%v got=%v", operSt, want, got)
+	}
+}
+
+func TestConfigJobFindNew(t *testing.T) {
+	before := []ConfigJob{{Dn: "run-1"}, {Dn: "run-2"}}
+	known := configJobKnown(before)
+
+	if _, found := configJobFindNew(known, before); found {
+		t.Errorf("unexpected new job in %v", before)
+	}
+
+	after := append(before, ConfigJob{Dn: "run-3", OperSt: "running"})
+	job, found := configJobFindNew(known, after)
+	if !found {
+		t.Fatalf("new job not found in %v", after)
+	}
+	if job.Dn != "run-3" {
+		t.Errorf("want=run-3 got=%s", job.Dn)
+	}
+}
+
+func TestConfigJobCont(t *testing.T) {
+	want := "backupst/jobs-[uni/fabric/configimp-imp1]"
+	if got := dnConfigJobCont("fabric/" + rnImportConfig("imp1")); got != want {
+		t.Errorf("want=%s got=%s", want, got)
+	}
+}
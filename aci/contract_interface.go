@@ -0,0 +1,74 @@
+package aci
+
+import (
+	"fmt"
+)
+
+func rnContractInterface(iface string) string {
+	return "cif-" + iface
+}
+
+func dnContractInterface(tenant, iface string) string {
+	return rnTenant(tenant) + "/" + rnContractInterface(iface)
+}
+
+// ContractInterfaceAdd exports a contract from contractTenant into tenant, as a contract interface.
+// EPGs in tenant consume the exported contract with EPGContractInterfaceConsumedAdd().
+// The contract scope should be ContractScopeGlobal.
+func (c *Client) ContractInterfaceAdd(tenant, iface, contractTenant, contract, descr string) error {
+	return c.moPost("ContractInterfaceAdd", dnContractInterface(tenant, iface), contractInterfaceJSON(tenant, iface, contractTenant, contract, descr))
+}
+
+// contractInterfaceJSON builds the vzCPIf object pointing to the exported contract.
+func contractInterfaceJSON(tenant, iface, contractTenant, contract, descr string) string {
+
+	rn := rnContractInterface(iface)
+
+	dn := dnContractInterface(tenant, iface)
+
+	return fmt.Sprintf(`{"vzCPIf":{"attributes":{"dn":"uni/%s","name":"%s","descr":"%s","rn":"%s","status":"created"},"children":[{"vzRsIf":{"attributes":{"tDn":"uni/%s","status":"created,modified"}}}]}}`,
+		dn, iface, descr, rn, dnContract(contractTenant, contract))
+}
+
+// ContractInterfaceDel deletes a contract interface from a tenant.
+func (c *Client) ContractInterfaceDel(tenant, iface string) error {
+	return c.moChildDel("ContractInterfaceDel", "fvTenant", rnTenant(tenant), "vzCPIf", rnContractInterface(iface))
+}
+
+// ContractInterfaceList retrieves the list of contract interfaces in a tenant.
+func (c *Client) ContractInterfaceList(tenant string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("ContractInterfaceList", rnTenant(tenant), "vzCPIf")
+}
+
+// ContractInterfaceContractGet retrieves the DN of the contract exported by a contract interface.
+func (c *Client) ContractInterfaceContractGet(tenant, iface string) (string, error) {
+
+	me := "ContractInterfaceContractGet"
+
+	attrs, errList := c.moChildrenAttributes(me, dnContractInterface(tenant, iface), "vzRsIf")
+	if errList != nil {
+		return "", errList
+	}
+
+	if len(attrs) != 1 {
+		return "", fmt.Errorf("%s: bad attr count=%d", me, len(attrs))
+	}
+
+	return mapString(attrs[0], "tDn"), nil
+}
+
+// EPGContractInterfaceConsumedAdd attaches contract interface as consumed by EPG.
+func (c *Client) EPGContractInterfaceConsumedAdd(tenant, applicationProfile, epg, iface string) error {
+	j := fmt.Sprintf(`{"fvRsConsIf":{"attributes":{"tnVzCPIfName":"%s","status":"created,modified"}}}`, iface)
+	return c.moPost("EPGContractInterfaceConsumedAdd", dnAEPG(tenant, applicationProfile, epg), j)
+}
+
+// EPGContractInterfaceConsumedDel detaches consumed contract interface from EPG.
+func (c *Client) EPGContractInterfaceConsumedDel(tenant, applicationProfile, epg, iface string) error {
+	return c.moChildDel("EPGContractInterfaceConsumedDel", "fvAEPg", dnAEPG(tenant, applicationProfile, epg), "fvRsConsIf", "rsconsIf-"+iface)
+}
+
+// EPGContractInterfaceConsumedList retrieves the list of contract interfaces consumed by EPG.
+func (c *Client) EPGContractInterfaceConsumedList(tenant, applicationProfile, epg string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("EPGContractInterfaceConsumedList", dnAEPG(tenant, applicationProfile, epg), "fvRsConsIf")
+}
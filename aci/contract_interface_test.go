@@ -0,0 +1,32 @@
+package aci
+
+import (
+	"testing"
+)
+
+func TestContractInterfaceJSON(t *testing.T) {
+	j := contractInterfaceJSON("consumer1", "web-if", "provider1", "web", "exported web")
+
+	objs, errObj := jsonImdataObjects(testDebug{t}, []byte(`{"totalCount":"1","imdata":[`+j+`]}`), "TestContractInterfaceJSON")
+	if errObj != nil {
+		t.Fatalf("bad json: %v: %s", errObj, j)
+	}
+	if len(objs) != 1 || objs[0].class != "vzCPIf" {
+		t.Fatalf("want one vzCPIf: %s", j)
+	}
+
+	iface := objs[0]
+	for k, want := range map[string]string{"dn": "uni/tn-consumer1/cif-web-if", "name": "web-if", "rn": "cif-web-if", "descr": "exported web"} {
+		if got := mapString(iface.attr, k); got != want {
+			t.Errorf("attribute=%s want=%s got=%s", k, want, got)
+		}
+	}
+
+	rels := iface.childrenByClass("vzRsIf")
+	if len(rels) != 1 {
+		t.Fatalf("want one vzRsIf: %s", j)
+	}
+	if got := mapString(rels[0].attr, "tDn"); got != "uni/tn-provider1/brc-web" {
+		t.Errorf("want tDn=uni/tn-provider1/brc-web got=%s", got)
+	}
+}
@@ -0,0 +1,99 @@
+package aci
+
+import (
+	"fmt"
+)
+
+// Contract scopes.
+const (
+	ContractScopeContext            = "context" // VRF.
+	ContractScopeTenant             = "tenant"
+	ContractScopeApplicationProfile = "application-profile"
+	ContractScopeGlobal             = "global"
+)
+
+// Contract holds the settings of a contract.
+type Contract struct {
+	Dn         string
+	Name       string
+	Descr      string
+	Scope      string // ContractScopeContext, ContractScopeTenant, ContractScopeApplicationProfile, ContractScopeGlobal
+	QosClass   string // QosClassUnspecified, QosClassLevel1, ... QosClassLevel6
+	TargetDSCP string // Example: "unspecified", "AF11", "EF", "CS3"
+}
+
+// ContractGet retrieves the settings of a contract.
+func (c *Client) ContractGet(tenant, contract string) (Contract, error) {
+
+	me := "ContractGet"
+
+	key := "vzBrCP"
+
+	dn := dnContract(tenant, contract)
+
+	api := "/api/node/mo/uni/" + dn + ".json"
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return Contract{}, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	attrs, errAttr := jsonImdataAttributes(c, body, key, me)
+	if errAttr != nil {
+		return Contract{}, fmt.Errorf("%s: %v", me, errAttr)
+	}
+
+	if len(attrs) != 1 {
+		return Contract{}, fmt.Errorf("%s: bad attr count=%d", me, len(attrs))
+	}
+
+	attr := attrs[0]
+
+	return Contract{
+		Dn:         mapString(attr, "dn"),
+		Name:       mapString(attr, "name"),
+		Descr:      mapString(attr, "descr"),
+		Scope:      mapString(attr, "scope"),
+		QosClass:   mapString(attr, "prio"),
+		TargetDSCP: mapString(attr, "targetDscp"),
+	}, nil
+}
+
+// contractAttrSet modifies an attribute of an existing contract.
+func (c *Client) contractAttrSet(me, tenant, contract, attr, value string) error {
+
+	dn := dnContract(tenant, contract)
+
+	j := fmt.Sprintf(`{"vzBrCP":{"attributes":{"dn":"uni/%s","%s":"%s","status":"modified"}}}`,
+		dn, attr, value)
+
+	return c.moPost(me, dn, j)
+}
+
+// ContractScopeSet sets the scope for the contract.
+// scope: ContractScopeContext, ContractScopeTenant, ContractScopeApplicationProfile, ContractScopeGlobal
+func (c *Client) ContractScopeSet(tenant, contract, scope string) error {
+	return c.contractAttrSet("ContractScopeSet", tenant, contract, "scope", scope)
+}
+
+// ContractQosClassSet sets the QoS class for the contract.
+// qosClass: QosClassUnspecified, QosClassLevel1, ... QosClassLevel6
+func (c *Client) ContractQosClassSet(tenant, contract, qosClass string) error {
+	return c.contractAttrSet("ContractQosClassSet", tenant, contract, "prio", qosClass)
+}
+
+// ContractTargetDSCPSet sets the target DSCP for the contract. Example: dscp="EF"
+func (c *Client) ContractTargetDSCPSet(tenant, contract, dscp string) error {
+	return c.contractAttrSet("ContractTargetDSCPSet", tenant, contract, "targetDscp", dscp)
+}
+
+// ContractDescrSet sets the description for the contract.
+func (c *Client) ContractDescrSet(tenant, contract, descr string) error {
+	return c.contractAttrSet("ContractDescrSet", tenant, contract, "descr", descr)
+}
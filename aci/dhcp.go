@@ -0,0 +1,159 @@
+package aci
+
+import (
+	"fmt"
+)
+
+// DHCP relay policy owners, for BridgeDomainDHCPLabelAdd.
+const (
+	DHCPOwnerTenant = "tenant" // Relay policy defined in the tenant.
+	DHCPOwnerInfra  = "infra"  // Relay policy defined in the infra (access policies).
+)
+
+// DHCPRelayProvider holds a DHCP server reachable through a provider EPG (dhcpRsProv).
+type DHCPRelayProvider struct {
+	EPG  string // Provider EPG DN. Use ApplicationEPGDn() or L3ExtOutEPGDn() to build it.
+	Addr string // DHCP server address. Example: "10.0.0.10"
+}
+
+// ApplicationEPGDn builds the DN for an application EPG. Example: "uni/tn-t1/ap-ap1/epg-epg1"
+func ApplicationEPGDn(tenant, applicationProfile, epg string) string {
+	return "uni/" + dnAEPG(tenant, applicationProfile, epg)
+}
+
+// L3ExtOutEPGDn builds the DN for an external EPG. Example: "uni/tn-t1/out-out1/instP-ext1"
+func L3ExtOutEPGDn(tenant, out, epg string) string {
+	return "uni/" + dnL3ExtEPG(tenant, out, epg)
+}
+
+func rnDHCPRelay(policy string) string {
+	return "relayp-" + policy
+}
+
+func rnDHCPOptionPolicy(policy string) string {
+	return "dhcpoptpol-" + policy
+}
+
+// dhcpRelayJSON builds the dhcpRelayP object for a relay policy under parentDn. Example: parentDn="tn-t1" or parentDn="infra"
+func dhcpRelayJSON(parentDn, policy, owner, descr string) string {
+
+	rn := rnDHCPRelay(policy)
+
+	return fmt.Sprintf(`{"dhcpRelayP":{"attributes":{"dn":"uni/%s/%s","name":"%s","descr":"%s","owner":"%s","rn":"%s","status":"created"}}}`,
+		parentDn, rn, policy, descr, owner, rn)
+}
+
+func (c *Client) dhcpRelayAdd(me, parentDn, policy, owner, descr string) error {
+	return c.moPost(me, parentDn+"/"+rnDHCPRelay(policy), dhcpRelayJSON(parentDn, policy, owner, descr))
+}
+
+func (c *Client) dhcpRelayProviderList(me, policyDn string) ([]DHCPRelayProvider, error) {
+
+	attrs, errList := c.moChildrenAttributes(me, policyDn, "dhcpRsProv")
+	if errList != nil {
+		return nil, errList
+	}
+
+	list := make([]DHCPRelayProvider, 0, len(attrs))
+	for _, attr := range attrs {
+		list = append(list, DHCPRelayProvider{
+			EPG:  mapString(attr, "tDn"),
+			Addr: mapString(attr, "addr"),
+		})
+	}
+
+	return list, nil
+}
+
+// DHCPRelayPolicyAdd creates a DHCP relay policy in a tenant.
+func (c *Client) DHCPRelayPolicyAdd(tenant, policy, descr string) error {
+	return c.dhcpRelayAdd("DHCPRelayPolicyAdd", rnTenant(tenant), policy, DHCPOwnerTenant, descr)
+}
+
+// DHCPRelayPolicyDel deletes a DHCP relay policy from a tenant.
+func (c *Client) DHCPRelayPolicyDel(tenant, policy string) error {
+	return c.moChildDel("DHCPRelayPolicyDel", "fvTenant", rnTenant(tenant), "dhcpRelayP", rnDHCPRelay(policy))
+}
+
+// DHCPRelayPolicyList retrieves the list of DHCP relay policies in a tenant.
+func (c *Client) DHCPRelayPolicyList(tenant string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("DHCPRelayPolicyList", rnTenant(tenant), "dhcpRelayP")
+}
+
+// DHCPRelayProviderAdd adds a DHCP server, reachable through a provider EPG, to a tenant DHCP relay policy.
+func (c *Client) DHCPRelayProviderAdd(tenant, policy, providerEPG, serverAddr string) error {
+	j := fmt.Sprintf(`{"dhcpRsProv":{"attributes":{"tDn":"%s","addr":"%s","status":"created,modified"}}}`, providerEPG, serverAddr)
+	return c.moPost("DHCPRelayProviderAdd", rnTenant(tenant)+"/"+rnDHCPRelay(policy), j)
+}
+
+// DHCPRelayProviderDel removes a provider EPG from a tenant DHCP relay policy.
+func (c *Client) DHCPRelayProviderDel(tenant, policy, providerEPG string) error {
+	return c.moChildDel("DHCPRelayProviderDel", "dhcpRelayP", rnTenant(tenant)+"/"+rnDHCPRelay(policy), "dhcpRsProv", "rsprov-["+providerEPG+"]")
+}
+
+// DHCPRelayProviderList retrieves the list of DHCP servers in a tenant DHCP relay policy.
+func (c *Client) DHCPRelayProviderList(tenant, policy string) ([]DHCPRelayProvider, error) {
+	return c.dhcpRelayProviderList("DHCPRelayProviderList", rnTenant(tenant)+"/"+rnDHCPRelay(policy))
+}
+
+// DHCPRelayInfraPolicyAdd creates a DHCP relay policy in the infra (access policies), shared by all tenants.
+func (c *Client) DHCPRelayInfraPolicyAdd(policy, descr string) error {
+	return c.dhcpRelayAdd("DHCPRelayInfraPolicyAdd", "infra", policy, DHCPOwnerInfra, descr)
+}
+
+// DHCPRelayInfraPolicyDel deletes a DHCP relay policy from the infra.
+func (c *Client) DHCPRelayInfraPolicyDel(policy string) error {
+	return c.moChildDel("DHCPRelayInfraPolicyDel", "infraInfra", "infra", "dhcpRelayP", rnDHCPRelay(policy))
+}
+
+// DHCPRelayInfraPolicyList retrieves the list of DHCP relay policies in the infra.
+func (c *Client) DHCPRelayInfraPolicyList() ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("DHCPRelayInfraPolicyList", "infra", "dhcpRelayP")
+}
+
+// DHCPRelayInfraProviderAdd adds a DHCP server, reachable through a provider EPG, to an infra DHCP relay policy.
+func (c *Client) DHCPRelayInfraProviderAdd(policy, providerEPG, serverAddr string) error {
+	j := fmt.Sprintf(`{"dhcpRsProv":{"attributes":{"tDn":"%s","addr":"%s","status":"created,modified"}}}`, providerEPG, serverAddr)
+	return c.moPost("DHCPRelayInfraProviderAdd", "infra/"+rnDHCPRelay(policy), j)
+}
+
+// DHCPRelayInfraProviderDel removes a provider EPG from an infra DHCP relay policy.
+func (c *Client) DHCPRelayInfraProviderDel(policy, providerEPG string) error {
+	return c.moChildDel("DHCPRelayInfraProviderDel", "dhcpRelayP", "infra/"+rnDHCPRelay(policy), "dhcpRsProv", "rsprov-["+providerEPG+"]")
+}
+
+// DHCPRelayInfraProviderList retrieves the list of DHCP servers in an infra DHCP relay policy.
+func (c *Client) DHCPRelayInfraProviderList(policy string) ([]DHCPRelayProvider, error) {
+	return c.dhcpRelayProviderList("DHCPRelayInfraProviderList", "infra/"+rnDHCPRelay(policy))
+}
+
+// DHCPOptionPolicyAdd creates a DHCP option policy in a tenant.
+func (c *Client) DHCPOptionPolicyAdd(tenant, policy, descr string) error {
+	return c.tenantPolicyAdd("DHCPOptionPolicyAdd", tenant, "dhcpOptionPol", rnDHCPOptionPolicy(policy), policy, descr, "")
+}
+
+// DHCPOptionPolicyDel deletes a DHCP option policy from a tenant.
+func (c *Client) DHCPOptionPolicyDel(tenant, policy string) error {
+	return c.moChildDel("DHCPOptionPolicyDel", "fvTenant", rnTenant(tenant), "dhcpOptionPol", rnDHCPOptionPolicy(policy))
+}
+
+// DHCPOptionPolicyList retrieves the list of DHCP option policies in a tenant.
+func (c *Client) DHCPOptionPolicyList(tenant string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("DHCPOptionPolicyList", rnTenant(tenant), "dhcpOptionPol")
+}
+
+// DHCPOptionAdd adds an option to a DHCP option policy. Example: option="domain-name" id="15" data="example.com"
+func (c *Client) DHCPOptionAdd(tenant, policy, option, id, data string) error {
+	j := fmt.Sprintf(`{"dhcpOption":{"attributes":{"name":"%s","id":"%s","data":"%s","status":"created,modified"}}}`, option, id, data)
+	return c.moPost("DHCPOptionAdd", rnTenant(tenant)+"/"+rnDHCPOptionPolicy(policy), j)
+}
+
+// DHCPOptionDel deletes an option from a DHCP option policy.
+func (c *Client) DHCPOptionDel(tenant, policy, option string) error {
+	return c.moChildDel("DHCPOptionDel", "dhcpOptionPol", rnTenant(tenant)+"/"+rnDHCPOptionPolicy(policy), "dhcpOption", "opt-"+option)
+}
+
+// DHCPOptionList retrieves the list of options in a DHCP option policy.
+func (c *Client) DHCPOptionList(tenant, policy string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("DHCPOptionList", rnTenant(tenant)+"/"+rnDHCPOptionPolicy(policy), "dhcpOption")
+}
@@ -0,0 +1,31 @@
+package aci
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDHCPRelayJSON(t *testing.T) {
+	dhcpRelayJSONTest(t, rnTenant("t1"), DHCPOwnerTenant, "uni/tn-t1/relayp-relay1")
+	dhcpRelayJSONTest(t, "infra", DHCPOwnerInfra, "uni/infra/relayp-relay1")
+}
+
+func dhcpRelayJSONTest(t *testing.T, parentDn, owner, wantDn string) {
+	j := dhcpRelayJSON(parentDn, "relay1", owner, "descr1")
+
+	var obj map[string]map[string]map[string]interface{}
+	if errUnmarshal := json.Unmarshal([]byte(j), &obj); errUnmarshal != nil {
+		t.Fatalf("parent=%s invalid json: %v: %s", parentDn, errUnmarshal, j)
+	}
+
+	attr := obj["dhcpRelayP"]["attributes"]
+	if attr == nil {
+		t.Fatalf("parent=%s missing dhcpRelayP: %s", parentDn, j)
+	}
+
+	for k, want := range map[string]string{"dn": wantDn, "name": "relay1", "owner": owner, "descr": "descr1", "rn": "relayp-relay1", "status": "created"} {
+		if got := mapString(attr, k); got != want {
+			t.Errorf("parent=%s attribute=%s want=%s got=%s", parentDn, k, want, got)
+		}
+	}
+}
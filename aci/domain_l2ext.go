@@ -1,5 +1,126 @@
 package aci
 
+import (
+	"bytes"
+	"fmt"
+)
+
 func rnL2Dom(dom string) string {
 	return "l2dom-" + dom
 }
+
+// ExternalBridgedDomainAdd creates a new L2 External Domain associated with a VLAN pool.
+func (c *Client) ExternalBridgedDomainAdd(dom, vlanpoolName, vlanpoolMode string) error {
+
+	me := "ExternalBridgedDomainAdd"
+
+	pool := nameVP(vlanpoolName, vlanpoolMode)
+
+	rn := rnL2Dom(dom)
+
+	api := "/api/node/mo/uni/" + rn + ".json"
+
+	url := c.getURL(api)
+
+	j := fmt.Sprintf(`{"l2extDomP":{"attributes":{"dn":"uni/%s","name":"%s","rn":"%s","status":"created"},"children":[{"infraRsVlanNs":{"attributes":{"tDn":"uni/infra/%s","status":"created"}}}]}}`,
+		rn, dom, rn, pool)
+
+	c.debugf("%s: url=%s json=%s", me, url, j)
+
+	body, errPost := c.post(url, contentTypeJSON, bytes.NewBufferString(j))
+	if errPost != nil {
+		return fmt.Errorf("%s: %v", me, errPost)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return parseJSONError(body)
+}
+
+// ExternalBridgedDomainDel deletes an existing L2 External Domain.
+func (c *Client) ExternalBridgedDomainDel(dom string) error {
+
+	me := "ExternalBridgedDomainDel"
+
+	rn := rnL2Dom(dom)
+
+	api := "/api/node/mo/uni.json"
+
+	url := c.getURL(api)
+
+	j := fmt.Sprintf(`{"polUni":{"attributes":{"dn":"uni","status":"modified"},"children":[{"l2extDomP":{"attributes":{"dn":"uni/%s","status":"deleted"}}}]}}`,
+		rn)
+
+	c.debugf("%s: url=%s json=%s", me, url, j)
+
+	body, errPost := c.post(url, contentTypeJSON, bytes.NewBufferString(j))
+	if errPost != nil {
+		return fmt.Errorf("%s: %v", me, errPost)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return parseJSONError(body)
+}
+
+// ExternalBridgedDomainList retrieves the list of L2 External Domains.
+func (c *Client) ExternalBridgedDomainList() ([]map[string]interface{}, error) {
+
+	me := "ExternalBridgedDomainList"
+
+	key := "l2extDomP"
+
+	api := "/api/node/mo/uni.json?query-target=subtree&target-subtree-class=" + key
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return jsonImdataAttributes(c, body, key, me)
+}
+
+// ExternalBridgedDomainVlanPoolGet retrieves the VLAN pool for the L2 External Domain.
+func (c *Client) ExternalBridgedDomainVlanPoolGet(dom string) (string, error) {
+
+	me := "ExternalBridgedDomainVlanPoolGet"
+
+	key := "infraRsVlanNs"
+
+	rn := rnL2Dom(dom)
+
+	api := "/api/node/mo/uni/" + rn + ".json?query-target=children&target-subtree-class=" + key
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return "", fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	attrs, errAttr := jsonImdataAttributes(c, body, key, me)
+	if errAttr != nil {
+		return "", errAttr
+	}
+
+	if len(attrs) < 1 {
+		return "", fmt.Errorf("%s: empty list of vlanpool", me)
+	}
+
+	pool := mapString(attrs[0], "tDn")
+	if pool == "" {
+		return "", fmt.Errorf("%s: vlanpool not found", me)
+	}
+
+	return pool, nil
+}
@@ -0,0 +1,278 @@
+package aci
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Endpoint holds a client endpoint (fvCEp) learned by the fabric.
+type Endpoint struct {
+	Dn                 string // Endpoint DN. Example: "uni/tn-prod/ap-app/epg-web/cep-00:50:56:01:02:03"
+	Mac                string
+	IPs                []string
+	Encap              string // Example: "vlan-100"
+	Tenant             string
+	ApplicationProfile string
+	EPG                string
+	Paths              []Path // Leaf/port/PC/vPC where the endpoint was learned.
+}
+
+// endpointFromObject builds an endpoint from fvCEp retrieved with its fvIp and fvRsCEpToPathEp children.
+func endpointFromObject(c hasDebugf, obj imdataObject) Endpoint {
+
+	e := Endpoint{
+		Dn:    mapString(obj.attr, "dn"),
+		Mac:   mapString(obj.attr, "mac"),
+		Encap: mapString(obj.attr, "encap"),
+	}
+
+	for _, s := range strings.Split(e.Dn, "/") {
+		switch {
+		case strings.HasPrefix(s, "tn-"):
+			e.Tenant = stripPrefix(s, "tn-")
+		case strings.HasPrefix(s, "ap-"):
+			e.ApplicationProfile = stripPrefix(s, "ap-")
+		case strings.HasPrefix(s, "epg-"):
+			e.EPG = stripPrefix(s, "epg-")
+		}
+	}
+
+	if ip := mapString(obj.attr, "ip"); ip != "" && ip != "0.0.0.0" {
+		e.IPs = append(e.IPs, ip)
+	}
+
+	for _, child := range obj.children {
+		switch child.class {
+		case "fvIp":
+			addr := mapString(child.attr, "addr")
+			if addr != "" && !stringIn(addr, e.IPs) {
+				e.IPs = append(e.IPs, addr)
+			}
+		case "fvRsCEpToPathEp":
+			p, errPath := PathParse(mapString(child.attr, "tDn"))
+			if errPath != nil {
+				c.debugf("endpointFromObject: %v", errPath)
+				continue
+			}
+			e.Paths = append(e.Paths, p)
+		}
+	}
+
+	return e
+}
+
+func stringIn(s string, list []string) bool {
+	for _, i := range list {
+		if i == s {
+			return true
+		}
+	}
+	return false
+}
+
+// endpointQuery retrieves endpoints, along with their addresses and paths, from api.
+// api must already hold a query string.
+func (c *Client) endpointQuery(me, api string) ([]Endpoint, error) {
+
+	url := c.getURL(api + "&rsp-subtree=children&rsp-subtree-class=fvIp,fvRsCEpToPathEp")
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	objs, errObj := jsonImdataObjects(c, body, me)
+	if errObj != nil {
+		return nil, errObj
+	}
+
+	var list []Endpoint
+	for _, obj := range objs {
+		if obj.class == "fvCEp" {
+			list = append(list, endpointFromObject(c, obj))
+		}
+	}
+
+	return list, nil
+}
+
+// endpointParentQuery finds the endpoints which are parents of the objects of class key selected by filter.
+func (c *Client) endpointParentQuery(me, key, filter string) ([]Endpoint, error) {
+
+	api := "/api/node/class/" + key + ".json" + queryOptions(queryFilter(filter))
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	attrs, errAttr := jsonImdataAttributes(c, body, key, me)
+	if errAttr != nil {
+		return nil, fmt.Errorf("%s: %v", me, errAttr)
+	}
+
+	var dns []string
+	for _, attr := range attrs {
+		dn := extractParent(mapString(attr, "dn"))
+		if dn != "" && !stringIn(dn, dns) {
+			dns = append(dns, dn)
+		}
+	}
+
+	var list []Endpoint
+	for _, dn := range dns {
+		eps, errEp := c.endpointQuery(me, "/api/node/mo/"+dn+".json?query-target=self")
+		if errEp != nil {
+			return nil, errEp
+		}
+		list = append(list, eps...)
+	}
+
+	return list, nil
+}
+
+// EndpointFindByMAC finds the endpoints with a MAC address. Example: mac="00:50:56:01:02:03"
+func (c *Client) EndpointFindByMAC(mac string) ([]Endpoint, error) {
+
+	me := "EndpointFindByMAC"
+
+	key := "fvCEp"
+
+	api := "/api/node/class/" + key + ".json" + queryOptions(queryFilter(queryEq(key, "mac", strings.ToUpper(mac))))
+
+	return c.endpointQuery(me, api)
+}
+
+// EndpointFindByIP finds the endpoints with an IP address. Example: ip="10.1.2.3"
+func (c *Client) EndpointFindByIP(ip string) ([]Endpoint, error) {
+
+	me := "EndpointFindByIP"
+
+	key := "fvCEp"
+
+	api := "/api/node/class/" + key + ".json" + queryOptions(queryFilter(queryEq(key, "ip", ip)))
+
+	list, errPrimary := c.endpointQuery(me, api)
+	if errPrimary != nil {
+		return nil, errPrimary
+	}
+
+	// endpoints may hold multiple addresses
+	secondary, errSecondary := c.endpointParentQuery(me, "fvIp", queryEq("fvIp", "addr", ip))
+	if errSecondary != nil {
+		return nil, errSecondary
+	}
+
+	return endpointMerge(list, secondary), nil
+}
+
+// EndpointFindByVM finds the endpoints attached to a virtual machine. Example: vm="web01"
+func (c *Client) EndpointFindByVM(vm string) ([]Endpoint, error) {
+
+	me := "EndpointFindByVM"
+
+	key := "compVm"
+
+	api := "/api/node/class/" + key + ".json" + queryOptions(queryFilter(queryEq(key, "name", vm)))
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	attrs, errAttr := jsonImdataAttributes(c, body, key, me)
+	if errAttr != nil {
+		return nil, fmt.Errorf("%s: %v", me, errAttr)
+	}
+
+	var list []Endpoint
+	for _, attr := range attrs {
+		eps, errEp := c.endpointParentQuery(me, "fvRsToVm", queryEq("fvRsToVm", "tDn", mapString(attr, "dn")))
+		if errEp != nil {
+			return nil, errEp
+		}
+		list = endpointMerge(list, eps)
+	}
+
+	return list, nil
+}
+
+// EndpointListByEPG retrieves the endpoints learned in an application EPG.
+func (c *Client) EndpointListByEPG(tenant, applicationProfile, epg string) ([]Endpoint, error) {
+
+	me := "EndpointListByEPG"
+
+	dnE := dnAEPG(tenant, applicationProfile, epg)
+
+	api := "/api/node/mo/uni/" + dnE + ".json?query-target=children&target-subtree-class=fvCEp"
+
+	return c.endpointQuery(me, api)
+}
+
+// EndpointListByPath retrieves the endpoints learned on a path, given by its DN.
+// Use PathPort() to build the path DN for an individual port.
+func (c *Client) EndpointListByPath(path string) ([]Endpoint, error) {
+	return c.endpointParentQuery("EndpointListByPath", "fvRsCEpToPathEp", queryEq("fvRsCEpToPathEp", "tDn", path))
+}
+
+// EndpointListByInterface retrieves the endpoints learned on a leaf interface. Example: pod="1" node="101" port="eth1/1"
+func (c *Client) EndpointListByInterface(pod, node, port string) ([]Endpoint, error) {
+	return c.EndpointListByPath(PathPort(pod, node, port))
+}
+
+// EndpointHistory retrieves the endpoint records (epRecord) for a MAC address, most recent first.
+// The records report where the endpoint has been learned over time.
+func (c *Client) EndpointHistory(mac string) ([]map[string]interface{}, error) {
+
+	me := "EndpointHistory"
+
+	key := "epRecord"
+
+	api := "/api/node/class/" + key + ".json" + queryOptions(queryFilter(queryWcard(key, "affected", "cep-"+strings.ToUpper(mac))), "order-by="+key+".created|desc")
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return jsonImdataAttributes(c, body, key, me)
+}
+
+// endpointMerge appends endpoints from b not found in a.
+func endpointMerge(a, b []Endpoint) []Endpoint {
+	for _, e := range b {
+		found := false
+		for _, i := range a {
+			if i.Dn == e.Dn {
+				found = true
+				break
+			}
+		}
+		if !found {
+			a = append(a, e)
+		}
+	}
+	return a
+}
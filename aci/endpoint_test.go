@@ -0,0 +1,24 @@
+package aci
+
+import (
+	"testing"
+)
+
+func TestEndpointParent(t *testing.T) {
+	endpointParentTest(t, "a/b/c", "a/b")
+	endpointParentTest(t, "abc", "")
+	endpointParentTest(t, "uni/tn-prod/ap-app/epg-web/cep-00:50:56:01:02:03/rscEpToPathEp-[topology/pod-1/paths-101/pathep-[eth1/1]]",
+		"uni/tn-prod/ap-app/epg-web/cep-00:50:56:01:02:03")
+	endpointParentTest(t, "uni/tn-prod/ap-app/epg-web/cep-00:50:56:01:02:03/rscEpToPathEp-[topology/pod-1/protpaths-101-102/pathep-[vpc-web]]",
+		"uni/tn-prod/ap-app/epg-web/cep-00:50:56:01:02:03")
+	endpointParentTest(t, "uni/tn-prod/ap-app/epg-web/cep-00:50:56:01:02:03/ip-[10.1.2.3]",
+		"uni/tn-prod/ap-app/epg-web/cep-00:50:56:01:02:03")
+	endpointParentTest(t, "uni/tn-prod/ap-app/epg-web/cep-00:50:56:01:02:03/rstoVm",
+		"uni/tn-prod/ap-app/epg-web/cep-00:50:56:01:02:03")
+}
+
+func endpointParentTest(t *testing.T, dn, want string) {
+	if got := extractParent(dn); got != want {
+		t.Errorf("dn=%s want=%s got=%s", dn, want, got)
+	}
+}
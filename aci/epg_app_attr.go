@@ -0,0 +1,140 @@
+package aci
+
+import (
+	"fmt"
+)
+
+// QoS classes for application EPGs.
+const (
+	QosClassUnspecified = "unspecified"
+	QosClassLevel1      = "level1"
+	QosClassLevel2      = "level2"
+	QosClassLevel3      = "level3"
+	QosClassLevel4      = "level4"
+	QosClassLevel5      = "level5"
+	QosClassLevel6      = "level6"
+)
+
+// ApplicationEPG holds the settings of an application EPG.
+type ApplicationEPG struct {
+	Dn                   string
+	Name                 string
+	Descr                string
+	BridgeDomain         string // Bridge domain name.
+	PreferredGroupMember bool   // EPG is member of the VRF preferred group.
+	IntraEPGIsolation    bool   // Communication between endpoints in the EPG is blocked.
+	FloodOnEncap         bool   // Flooding is restricted to the EPG encapsulation.
+	QosClass             string // QosClassUnspecified, QosClassLevel1, ... QosClassLevel6
+	Useg                 bool   // Microsegmented (attribute-based) EPG.
+}
+
+// applicationEPGAttrSet modifies an attribute of an existing application EPG.
+func (c *Client) applicationEPGAttrSet(me, tenant, applicationProfile, epg, attr, value string) error {
+
+	dnE := dnAEPG(tenant, applicationProfile, epg)
+
+	j := fmt.Sprintf(`{"fvAEPg":{"attributes":{"dn":"uni/%s","%s":"%s","status":"modified"}}}`,
+		dnE, attr, value)
+
+	return c.moPost(me, dnE, j)
+}
+
+// ApplicationEPGPreferredGroupSet includes/excludes the application EPG in/from the VRF preferred group.
+func (c *Client) ApplicationEPGPreferredGroupSet(tenant, applicationProfile, epg string, member bool) error {
+	value := "exclude"
+	if member {
+		value = "include"
+	}
+	return c.applicationEPGAttrSet("ApplicationEPGPreferredGroupSet", tenant, applicationProfile, epg, "prefGrMemb", value)
+}
+
+// ApplicationEPGIsolationSet enables/disables intra-EPG isolation for the application EPG.
+func (c *Client) ApplicationEPGIsolationSet(tenant, applicationProfile, epg string, isolation bool) error {
+	value := "unenforced"
+	if isolation {
+		value = "enforced"
+	}
+	return c.applicationEPGAttrSet("ApplicationEPGIsolationSet", tenant, applicationProfile, epg, "pcEnfPref", value)
+}
+
+// ApplicationEPGFloodOnEncapSet enables/disables flood in encapsulation for the application EPG.
+func (c *Client) ApplicationEPGFloodOnEncapSet(tenant, applicationProfile, epg string, enable bool) error {
+	return c.applicationEPGAttrSet("ApplicationEPGFloodOnEncapSet", tenant, applicationProfile, epg, "floodOnEncap", enabledDisabled(enable))
+}
+
+// ApplicationEPGQosClassSet sets the QoS class for the application EPG.
+// qosClass: QosClassUnspecified, QosClassLevel1, ... QosClassLevel6
+func (c *Client) ApplicationEPGQosClassSet(tenant, applicationProfile, epg, qosClass string) error {
+	return c.applicationEPGAttrSet("ApplicationEPGQosClassSet", tenant, applicationProfile, epg, "prio", qosClass)
+}
+
+// ApplicationEPGDescrSet sets the description for the application EPG.
+func (c *Client) ApplicationEPGDescrSet(tenant, applicationProfile, epg, descr string) error {
+	return c.applicationEPGAttrSet("ApplicationEPGDescrSet", tenant, applicationProfile, epg, "descr", descr)
+}
+
+// ApplicationEPGBridgeDomainSet binds the application EPG to another bridge domain.
+func (c *Client) ApplicationEPGBridgeDomainSet(tenant, applicationProfile, epg, bridgeDomain string) error {
+
+	me := "ApplicationEPGBridgeDomainSet"
+
+	dnE := dnAEPG(tenant, applicationProfile, epg)
+
+	j := fmt.Sprintf(`{"fvRsBd":{"attributes":{"tnFvBDName":"%s","status":"created,modified"}}}`,
+		bridgeDomain)
+
+	return c.moPost(me, dnE+"/rsbd", j)
+}
+
+// ApplicationEPGGet retrieves the settings of an application EPG.
+func (c *Client) ApplicationEPGGet(tenant, applicationProfile, epg string) (ApplicationEPG, error) {
+
+	me := "ApplicationEPGGet"
+
+	key := "fvAEPg"
+
+	dnE := dnAEPG(tenant, applicationProfile, epg)
+
+	api := "/api/node/mo/uni/" + dnE + ".json?rsp-subtree=children&rsp-subtree-class=fvRsBd"
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return ApplicationEPG{}, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	objs, errObj := jsonImdataObjects(c, body, me)
+	if errObj != nil {
+		return ApplicationEPG{}, errObj
+	}
+
+	if len(objs) != 1 || objs[0].class != key {
+		return ApplicationEPG{}, fmt.Errorf("%s: bad object count=%d", me, len(objs))
+	}
+
+	obj := objs[0]
+
+	useg := mapString(obj.attr, "isAttrBasedEPg")
+
+	e := ApplicationEPG{
+		Dn:                   mapString(obj.attr, "dn"),
+		Name:                 mapString(obj.attr, "name"),
+		Descr:                mapString(obj.attr, "descr"),
+		PreferredGroupMember: mapString(obj.attr, "prefGrMemb") == "include",
+		IntraEPGIsolation:    mapString(obj.attr, "pcEnfPref") == "enforced",
+		FloodOnEncap:         mapString(obj.attr, "floodOnEncap") == "enabled",
+		QosClass:             mapString(obj.attr, "prio"),
+		Useg:                 useg == "yes" || useg == "true",
+	}
+
+	for _, bd := range obj.childrenByClass("fvRsBd") {
+		e.BridgeDomain = mapString(bd.attr, "tnFvBDName")
+	}
+
+	return e, nil
+}
@@ -0,0 +1,86 @@
+package aci
+
+import (
+	"fmt"
+)
+
+// Immediacy options for EPG domain associations and static paths.
+const (
+	ImmediacyImmediate    = "immediate"     // Policy is deployed/resolved immediately.
+	ImmediacyLazy         = "lazy"          // On demand. Policy is deployed/resolved when needed.
+	ImmediacyPreProvision = "pre-provision" // Resolution only. Policy is resolved even before a VM/host is attached.
+)
+
+// DomainPhysical builds the DN for a physical domain. Example: "uni/phys-dom1"
+func DomainPhysical(domain string) string {
+	return "uni/" + domPhysRN(domain)
+}
+
+// DomainVMWare builds the DN for a VMWare VMM domain. Example: "uni/vmmp-VMware/dom-dom1"
+func DomainVMWare(domain string) string {
+	return "uni/" + rnVmmDomainVMWare(domain)
+}
+
+// ApplicationEPGDomainAdd associates an application EPG with a domain, given by its DN.
+// Use DomainPhysical() or DomainVMWare() to build domainDn.
+// deployImmediacy: ImmediacyImmediate, ImmediacyLazy, "" (empty means default)
+// resolutionImmediacy: ImmediacyImmediate, ImmediacyLazy, ImmediacyPreProvision, "" (empty means default)
+func (c *Client) ApplicationEPGDomainAdd(tenant, applicationProfile, epg, domainDn, deployImmediacy, resolutionImmediacy string) error {
+
+	me := "ApplicationEPGDomainAdd"
+
+	dnE := dnAEPG(tenant, applicationProfile, epg)
+
+	attrs := optionalAttr("instrImedcy", deployImmediacy) + optionalAttr("resImedcy", resolutionImmediacy)
+
+	j := fmt.Sprintf(`{"fvRsDomAtt":{"attributes":{"tDn":"%s"%s,"status":"created,modified"}}}`,
+		domainDn, attrs)
+
+	return c.moPost(me, dnE, j)
+}
+
+// ApplicationEPGDomainDel removes the association between an application EPG and a domain, given by its DN.
+func (c *Client) ApplicationEPGDomainDel(tenant, applicationProfile, epg, domainDn string) error {
+
+	me := "ApplicationEPGDomainDel"
+
+	dnE := dnAEPG(tenant, applicationProfile, epg)
+
+	j := fmt.Sprintf(`{"fvAEPg":{"attributes":{"dn":"uni/%s","status":"modified"},"children":[{"fvRsDomAtt":{"attributes":{"dn":"uni/%s/rsdomAtt-[%s]","status":"deleted"}}}]}}`,
+		dnE, dnE, domainDn)
+
+	return c.moPost(me, dnE, j)
+}
+
+// ApplicationEPGDomainPhysicalAdd associates an application EPG with a physical domain.
+func (c *Client) ApplicationEPGDomainPhysicalAdd(tenant, applicationProfile, epg, domain, deployImmediacy, resolutionImmediacy string) error {
+	return c.ApplicationEPGDomainAdd(tenant, applicationProfile, epg, DomainPhysical(domain), deployImmediacy, resolutionImmediacy)
+}
+
+// ApplicationEPGDomainPhysicalDel removes the association between an application EPG and a physical domain.
+func (c *Client) ApplicationEPGDomainPhysicalDel(tenant, applicationProfile, epg, domain string) error {
+	return c.ApplicationEPGDomainDel(tenant, applicationProfile, epg, DomainPhysical(domain))
+}
+
+// ApplicationEPGDomainVMWareAdd associates an application EPG with a VMWare VMM domain.
+func (c *Client) ApplicationEPGDomainVMWareAdd(tenant, applicationProfile, epg, domain, deployImmediacy, resolutionImmediacy string) error {
+	return c.ApplicationEPGDomainAdd(tenant, applicationProfile, epg, DomainVMWare(domain), deployImmediacy, resolutionImmediacy)
+}
+
+// ApplicationEPGDomainVMWareDel removes the association between an application EPG and a VMWare VMM domain.
+func (c *Client) ApplicationEPGDomainVMWareDel(tenant, applicationProfile, epg, domain string) error {
+	return c.ApplicationEPGDomainDel(tenant, applicationProfile, epg, DomainVMWare(domain))
+}
+
+// ApplicationEPGDomainList retrieves the list of domains associated with an application EPG.
+// The attribute tDn holds the domain DN; instrImedcy and resImedcy hold the immediacy options.
+func (c *Client) ApplicationEPGDomainList(tenant, applicationProfile, epg string) ([]map[string]interface{}, error) {
+
+	me := "ApplicationEPGDomainList"
+
+	key := "fvRsDomAtt"
+
+	dnE := dnAEPG(tenant, applicationProfile, epg)
+
+	return c.moChildrenAttributes(me, dnE, key)
+}
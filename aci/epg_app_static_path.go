@@ -0,0 +1,97 @@
+package aci
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Static path modes.
+const (
+	StaticPathModeTrunk    = "regular"  // Trunk. Traffic is tagged with the encap VLAN.
+	StaticPathModeAccess   = "native"   // Access (802.1P). Traffic is untagged, or tagged with VLAN 0.
+	StaticPathModeUntagged = "untagged" // Access (untagged). Traffic is untagged.
+)
+
+// StaticPath holds a static binding of an application EPG to a port, PC or vPC (fvRsPathAtt).
+type StaticPath struct {
+	Path      string // Path DN. Use PathPort(), PathPortChannel() or PathVPC() to build it.
+	Encap     string // Encapsulation. Example: "vlan-100"
+	Mode      string // StaticPathModeTrunk, StaticPathModeAccess, StaticPathModeUntagged, "" (empty means default)
+	Immediacy string // Deployment immediacy: ImmediacyImmediate, ImmediacyLazy, "" (empty means default)
+}
+
+func staticPathJSON(p StaticPath) string {
+	return fmt.Sprintf(`{"fvRsPathAtt":{"attributes":{"tDn":"%s","encap":"%s"%s%s,"status":"created,modified"}}}`,
+		p.Path, p.Encap, optionalAttr("mode", p.Mode), optionalAttr("instrImedcy", p.Immediacy))
+}
+
+// ApplicationEPGStaticPathAdd binds an application EPG to a port, PC or vPC.
+// Use PathPort(), PathPortChannel() or PathVPC() to build path.
+func (c *Client) ApplicationEPGStaticPathAdd(tenant, applicationProfile, epg, path, encap, mode, immediacy string) error {
+	p := StaticPath{Path: path, Encap: encap, Mode: mode, Immediacy: immediacy}
+	return c.applicationEPGStaticPathAdd("ApplicationEPGStaticPathAdd", tenant, applicationProfile, epg, []StaticPath{p})
+}
+
+// ApplicationEPGStaticPathBulkAdd binds an application EPG to many ports, PCs or vPCs in a single request.
+func (c *Client) ApplicationEPGStaticPathBulkAdd(tenant, applicationProfile, epg string, paths []StaticPath) error {
+	return c.applicationEPGStaticPathAdd("ApplicationEPGStaticPathBulkAdd", tenant, applicationProfile, epg, paths)
+}
+
+func (c *Client) applicationEPGStaticPathAdd(me, tenant, applicationProfile, epg string, paths []StaticPath) error {
+
+	if len(paths) < 1 {
+		return fmt.Errorf("%s: empty path list", me)
+	}
+
+	dnE := dnAEPG(tenant, applicationProfile, epg)
+
+	children := make([]string, 0, len(paths))
+	for _, p := range paths {
+		children = append(children, staticPathJSON(p))
+	}
+
+	j := fmt.Sprintf(`{"fvAEPg":{"attributes":{"dn":"uni/%s","status":"modified"},"children":[%s]}}`,
+		dnE, strings.Join(children, ","))
+
+	return c.moPost(me, dnE, j)
+}
+
+// ApplicationEPGStaticPathDel removes the static binding of an application EPG to a path.
+func (c *Client) ApplicationEPGStaticPathDel(tenant, applicationProfile, epg, path string) error {
+
+	me := "ApplicationEPGStaticPathDel"
+
+	dnE := dnAEPG(tenant, applicationProfile, epg)
+
+	j := fmt.Sprintf(`{"fvAEPg":{"attributes":{"dn":"uni/%s","status":"modified"},"children":[{"fvRsPathAtt":{"attributes":{"dn":"uni/%s/rspathAtt-[%s]","status":"deleted"}}}]}}`,
+		dnE, dnE, path)
+
+	return c.moPost(me, dnE, j)
+}
+
+// ApplicationEPGStaticPathList retrieves the list of static paths bound to an application EPG.
+func (c *Client) ApplicationEPGStaticPathList(tenant, applicationProfile, epg string) ([]StaticPath, error) {
+
+	me := "ApplicationEPGStaticPathList"
+
+	key := "fvRsPathAtt"
+
+	dnE := dnAEPG(tenant, applicationProfile, epg)
+
+	attrs, errAttr := c.moChildrenAttributes(me, dnE, key)
+	if errAttr != nil {
+		return nil, errAttr
+	}
+
+	list := make([]StaticPath, 0, len(attrs))
+	for _, attr := range attrs {
+		list = append(list, StaticPath{
+			Path:      mapString(attr, "tDn"),
+			Encap:     mapString(attr, "encap"),
+			Mode:      mapString(attr, "mode"),
+			Immediacy: mapString(attr, "instrImedcy"),
+		})
+	}
+
+	return list, nil
+}
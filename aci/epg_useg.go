@@ -0,0 +1,180 @@
+package aci
+
+import (
+	"fmt"
+)
+
+// uSeg criterion kinds.
+const (
+	UsegKindIP  = "ip"  // fvIpAttr
+	UsegKindMAC = "mac" // fvMacAttr
+	UsegKindVM  = "vm"  // fvVmAttr
+)
+
+// uSeg VM attribute types for ApplicationEPGUsegVMAttrAdd.
+const (
+	UsegVMAttrName       = "vm-name"
+	UsegVMAttrGuestOS    = "guest-os"
+	UsegVMAttrHypervisor = "hv"
+	UsegVMAttrDomain     = "domain"
+	UsegVMAttrID         = "vm"
+	UsegVMAttrDatacenter = "rootContName"
+	UsegVMAttrTag        = "tag"
+	UsegVMAttrVnic       = "vnic"
+)
+
+// uSeg VM attribute operators for ApplicationEPGUsegVMAttrAdd.
+const (
+	UsegOperatorEquals     = "equals"
+	UsegOperatorContains   = "contains"
+	UsegOperatorStartsWith = "startsWith"
+	UsegOperatorEndsWith   = "endsWith"
+)
+
+// UsegCriterion holds an attribute criterion for a microsegmented (uSeg) EPG.
+type UsegCriterion struct {
+	Kind     string // UsegKindIP, UsegKindMAC, UsegKindVM
+	Name     string
+	Type     string // VM attribute type. Empty for IP and MAC.
+	Operator string // VM attribute operator. Empty for IP and MAC.
+	Value    string // IP address/subnet, MAC address or VM attribute value.
+}
+
+func dnUsegCriteria(tenant, ap, epg string) string {
+	return dnAEPG(tenant, ap, epg) + "/crtrn"
+}
+
+// ApplicationEPGUsegAdd creates a new microsegmented (uSeg) EPG in an application profile and attached to a bridge domain.
+// match tells how multiple criteria are combined: "any", "all", "" (empty means default)
+func (c *Client) ApplicationEPGUsegAdd(tenant, applicationProfile, bridgeDomain, epg, match, descr string) error {
+
+	me := "ApplicationEPGUsegAdd"
+
+	rnE := rnAEPG(epg)
+
+	dnE := dnAEPG(tenant, applicationProfile, epg)
+
+	j := fmt.Sprintf(`{"fvAEPg":{"attributes":{"dn":"uni/%s","name":"%s","descr":"%s","isAttrBasedEPg":"true","rn":"%s","status":"created"},"children":[{"fvRsBd":{"attributes":{"tnFvBDName":"%s","status":"created,modified"}}},{"fvCrtrn":{"attributes":{"name":"default"%s,"rn":"crtrn","status":"created,modified"}}}]}}`,
+		dnE, epg, descr, rnE, bridgeDomain, optionalAttr("match", match))
+
+	return c.moPost(me, dnE, j)
+}
+
+// ApplicationEPGUsegList retrieves the list of microsegmented (uSeg) EPGs in an application profile.
+// Use ApplicationEPGDel() to delete a uSeg EPG.
+func (c *Client) ApplicationEPGUsegList(tenant, applicationProfile string) ([]map[string]interface{}, error) {
+
+	me := "ApplicationEPGUsegList"
+
+	key := "fvAEPg"
+
+	dnP := dnAP(tenant, applicationProfile)
+
+	api := "/api/node/mo/uni/" + dnP + ".json" + queryOptions("query-target=children", "target-subtree-class="+key, queryFilter(queryEq(key, "isAttrBasedEPg", "true")))
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return jsonImdataAttributes(c, body, key, me)
+}
+
+// usegCriterionAdd creates an attribute criterion under the uSeg EPG criteria container.
+// attrs holds the criterion attributes already formatted as `,"name":"value"` pairs.
+func (c *Client) usegCriterionAdd(me, tenant, applicationProfile, epg, class, rn, name, attrs string) error {
+
+	dnC := dnUsegCriteria(tenant, applicationProfile, epg)
+
+	j := fmt.Sprintf(`{"%s":{"attributes":{"dn":"uni/%s/%s","name":"%s"%s,"rn":"%s","status":"created"}}}`,
+		class, dnC, rn, name, attrs, rn)
+
+	return c.moPost(me, dnC+"/"+rn, j)
+}
+
+// usegCriterionDel deletes an attribute criterion from the uSeg EPG criteria container.
+func (c *Client) usegCriterionDel(me, tenant, applicationProfile, epg, class, rn string) error {
+	return c.moChildDel(me, "fvCrtrn", dnUsegCriteria(tenant, applicationProfile, epg), class, rn)
+}
+
+// ApplicationEPGUsegIPAdd adds an IP criterion to the uSeg EPG. Example: ip="10.0.0.0/24"
+func (c *Client) ApplicationEPGUsegIPAdd(tenant, applicationProfile, epg, name, ip string) error {
+	attrs := fmt.Sprintf(`,"ip":"%s"`, ip)
+	return c.usegCriterionAdd("ApplicationEPGUsegIPAdd", tenant, applicationProfile, epg, "fvIpAttr", "ipattr-"+name, name, attrs)
+}
+
+// ApplicationEPGUsegIPDel deletes an IP criterion from the uSeg EPG.
+func (c *Client) ApplicationEPGUsegIPDel(tenant, applicationProfile, epg, name string) error {
+	return c.usegCriterionDel("ApplicationEPGUsegIPDel", tenant, applicationProfile, epg, "fvIpAttr", "ipattr-"+name)
+}
+
+// ApplicationEPGUsegMACAdd adds a MAC criterion to the uSeg EPG. Example: mac="00:50:56:01:02:03"
+func (c *Client) ApplicationEPGUsegMACAdd(tenant, applicationProfile, epg, name, mac string) error {
+	attrs := fmt.Sprintf(`,"mac":"%s"`, mac)
+	return c.usegCriterionAdd("ApplicationEPGUsegMACAdd", tenant, applicationProfile, epg, "fvMacAttr", "macattr-"+name, name, attrs)
+}
+
+// ApplicationEPGUsegMACDel deletes a MAC criterion from the uSeg EPG.
+func (c *Client) ApplicationEPGUsegMACDel(tenant, applicationProfile, epg, name string) error {
+	return c.usegCriterionDel("ApplicationEPGUsegMACDel", tenant, applicationProfile, epg, "fvMacAttr", "macattr-"+name)
+}
+
+// ApplicationEPGUsegVMAttrAdd adds a VM attribute criterion to the uSeg EPG.
+// attrType: UsegVMAttrName, UsegVMAttrGuestOS, UsegVMAttrHypervisor, UsegVMAttrDomain, UsegVMAttrID, UsegVMAttrDatacenter, UsegVMAttrTag, UsegVMAttrVnic
+// operator: UsegOperatorEquals, UsegOperatorContains, UsegOperatorStartsWith, UsegOperatorEndsWith
+func (c *Client) ApplicationEPGUsegVMAttrAdd(tenant, applicationProfile, epg, name, attrType, operator, value string) error {
+	attrs := fmt.Sprintf(`,"type":"%s","operator":"%s","value":"%s"`, attrType, operator, value)
+	return c.usegCriterionAdd("ApplicationEPGUsegVMAttrAdd", tenant, applicationProfile, epg, "fvVmAttr", "vmattr-"+name, name, attrs)
+}
+
+// ApplicationEPGUsegVMNameAdd adds a VM name criterion to the uSeg EPG.
+func (c *Client) ApplicationEPGUsegVMNameAdd(tenant, applicationProfile, epg, name, operator, vmName string) error {
+	return c.ApplicationEPGUsegVMAttrAdd(tenant, applicationProfile, epg, name, UsegVMAttrName, operator, vmName)
+}
+
+// ApplicationEPGUsegVMAttrDel deletes a VM attribute criterion from the uSeg EPG.
+func (c *Client) ApplicationEPGUsegVMAttrDel(tenant, applicationProfile, epg, name string) error {
+	return c.usegCriterionDel("ApplicationEPGUsegVMAttrDel", tenant, applicationProfile, epg, "fvVmAttr", "vmattr-"+name)
+}
+
+// ApplicationEPGUsegCriteriaList retrieves the list of attribute criteria for the uSeg EPG.
+func (c *Client) ApplicationEPGUsegCriteriaList(tenant, applicationProfile, epg string) ([]UsegCriterion, error) {
+
+	me := "ApplicationEPGUsegCriteriaList"
+
+	dnC := dnUsegCriteria(tenant, applicationProfile, epg)
+
+	objs, errObj := c.moChildren(me, dnC, "fvIpAttr", "fvMacAttr", "fvVmAttr")
+	if errObj != nil {
+		return nil, errObj
+	}
+
+	list := make([]UsegCriterion, 0, len(objs))
+	for _, obj := range objs {
+		u := UsegCriterion{Name: mapString(obj.attr, "name")}
+		switch obj.class {
+		case "fvIpAttr":
+			u.Kind = UsegKindIP
+			u.Value = mapString(obj.attr, "ip")
+		case "fvMacAttr":
+			u.Kind = UsegKindMAC
+			u.Value = mapString(obj.attr, "mac")
+		case "fvVmAttr":
+			u.Kind = UsegKindVM
+			u.Type = mapString(obj.attr, "type")
+			u.Operator = mapString(obj.attr, "operator")
+			u.Value = mapString(obj.attr, "value")
+		default:
+			continue
+		}
+		list = append(list, u)
+	}
+
+	return list, nil
+}
@@ -0,0 +1,192 @@
+package aci
+
+import (
+	"fmt"
+)
+
+// ESG selector kinds.
+const (
+	ESGSelectorKindEPG = "epg" // fvEPgSelector
+	ESGSelectorKindIP  = "ip"  // fvEPSelector
+	ESGSelectorKindTag = "tag" // fvTagSelector
+)
+
+// ESGSelector holds an endpoint security group selector.
+type ESGSelector struct {
+	Kind     string // ESGSelectorKindEPG, ESGSelectorKindIP, ESGSelectorKindTag
+	Match    string // EPG DN, IP match expression or tag key.
+	Operator string // Tag value operator. Empty for EPG and IP.
+	Value    string // Tag value. Empty for EPG and IP.
+	Descr    string
+}
+
+func rnESG(esg string) string {
+	return "esg-" + esg
+}
+
+func dnESG(tenant, ap, esg string) string {
+	return dnAP(tenant, ap) + "/" + rnESG(esg)
+}
+
+// esgIPExpression: "10.0.0.0/24" => "ip=='10.0.0.0/24'"
+func esgIPExpression(ip string) string {
+	return "ip=='" + ip + "'"
+}
+
+// ESGAdd creates a new endpoint security group in an application profile and associated with a VRF.
+func (c *Client) ESGAdd(tenant, applicationProfile, esg, vrf, descr string) error {
+
+	rn := rnESG(esg)
+
+	dn := dnESG(tenant, applicationProfile, esg)
+
+	j := fmt.Sprintf(`{"fvESg":{"attributes":{"dn":"uni/%s","name":"%s","descr":"%s","rn":"%s","status":"created"},"children":[{"fvRsScope":{"attributes":{"tnFvCtxName":"%s","status":"created,modified"}}}]}}`,
+		dn, esg, descr, rn, vrf)
+
+	return c.moPost("ESGAdd", dn, j)
+}
+
+// ESGDel deletes an existing endpoint security group.
+func (c *Client) ESGDel(tenant, applicationProfile, esg string) error {
+	return c.moChildDel("ESGDel", "fvAp", dnAP(tenant, applicationProfile), "fvESg", rnESG(esg))
+}
+
+// ESGList retrieves the list of endpoint security groups in an application profile.
+func (c *Client) ESGList(tenant, applicationProfile string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("ESGList", dnAP(tenant, applicationProfile), "fvESg")
+}
+
+// esgChildAdd posts a child object to an endpoint security group.
+func (c *Client) esgChildAdd(me, tenant, applicationProfile, esg, j string) error {
+	return c.moPost(me, dnESG(tenant, applicationProfile, esg), j)
+}
+
+// esgChildDel deletes a child object from an endpoint security group.
+func (c *Client) esgChildDel(me, tenant, applicationProfile, esg, class, rn string) error {
+	return c.moChildDel(me, "fvESg", dnESG(tenant, applicationProfile, esg), class, rn)
+}
+
+// ESGVrfSet associates the endpoint security group with a VRF.
+func (c *Client) ESGVrfSet(tenant, applicationProfile, esg, vrf string) error {
+	j := fmt.Sprintf(`{"fvRsScope":{"attributes":{"tnFvCtxName":"%s","status":"created,modified"}}}`, vrf)
+	return c.esgChildAdd("ESGVrfSet", tenant, applicationProfile, esg, j)
+}
+
+// ESGVrfGet retrieves the VRF associated with the endpoint security group.
+func (c *Client) ESGVrfGet(tenant, applicationProfile, esg string) (string, error) {
+
+	me := "ESGVrfGet"
+
+	objs, errList := c.moChildren(me, dnESG(tenant, applicationProfile, esg), "fvRsScope")
+	if errList != nil {
+		return "", errList
+	}
+
+	if len(objs) != 1 {
+		return "", fmt.Errorf("%s: bad object count=%d", me, len(objs))
+	}
+
+	return mapString(objs[0].attr, "tnFvCtxName"), nil
+}
+
+// ESGSelectorEPGAdd adds an EPG selector to the endpoint security group.
+// All endpoints in the EPG (in the same tenant) are classified into the ESG.
+func (c *Client) ESGSelectorEPGAdd(tenant, applicationProfile, esg, epgApplicationProfile, epg, descr string) error {
+	dnE := dnAEPG(tenant, epgApplicationProfile, epg)
+	j := fmt.Sprintf(`{"fvEPgSelector":{"attributes":{"matchEpgDn":"uni/%s","descr":"%s","status":"created,modified"}}}`, dnE, descr)
+	return c.esgChildAdd("ESGSelectorEPGAdd", tenant, applicationProfile, esg, j)
+}
+
+// ESGSelectorEPGDel deletes an EPG selector from the endpoint security group.
+func (c *Client) ESGSelectorEPGDel(tenant, applicationProfile, esg, epgApplicationProfile, epg string) error {
+	dnE := dnAEPG(tenant, epgApplicationProfile, epg)
+	return c.esgChildDel("ESGSelectorEPGDel", tenant, applicationProfile, esg, "fvEPgSelector", "epgselector-[uni/"+dnE+"]")
+}
+
+// ESGSelectorIPAdd adds an IP subnet selector to the endpoint security group. Example: ip="10.0.0.0/24"
+func (c *Client) ESGSelectorIPAdd(tenant, applicationProfile, esg, ip, descr string) error {
+	j := fmt.Sprintf(`{"fvEPSelector":{"attributes":{"matchExpression":"%s","descr":"%s","status":"created,modified"}}}`, esgIPExpression(ip), descr)
+	return c.esgChildAdd("ESGSelectorIPAdd", tenant, applicationProfile, esg, j)
+}
+
+// ESGSelectorIPDel deletes an IP subnet selector from the endpoint security group.
+func (c *Client) ESGSelectorIPDel(tenant, applicationProfile, esg, ip string) error {
+	return c.esgChildDel("ESGSelectorIPDel", tenant, applicationProfile, esg, "fvEPSelector", "epselector-["+esgIPExpression(ip)+"]")
+}
+
+// ESGSelectorTagAdd adds a tag selector to the endpoint security group.
+// operator: "equals", "contains", "regex", "" (empty means default)
+func (c *Client) ESGSelectorTagAdd(tenant, applicationProfile, esg, key, operator, value, descr string) error {
+	j := fmt.Sprintf(`{"fvTagSelector":{"attributes":{"matchKey":"%s","matchValue":"%s"%s,"descr":"%s","status":"created,modified"}}}`,
+		key, value, optionalAttr("valueOperator", operator), descr)
+	return c.esgChildAdd("ESGSelectorTagAdd", tenant, applicationProfile, esg, j)
+}
+
+// ESGSelectorTagDel deletes a tag selector from the endpoint security group.
+func (c *Client) ESGSelectorTagDel(tenant, applicationProfile, esg, key, value string) error {
+	return c.esgChildDel("ESGSelectorTagDel", tenant, applicationProfile, esg, "fvTagSelector", "tagselectorkey-["+key+"]-value-["+value+"]")
+}
+
+// ESGSelectorList retrieves the list of selectors for the endpoint security group.
+func (c *Client) ESGSelectorList(tenant, applicationProfile, esg string) ([]ESGSelector, error) {
+
+	objs, errList := c.moChildren("ESGSelectorList", dnESG(tenant, applicationProfile, esg), "fvEPgSelector", "fvEPSelector", "fvTagSelector")
+	if errList != nil {
+		return nil, errList
+	}
+
+	list := make([]ESGSelector, 0, len(objs))
+	for _, obj := range objs {
+		s := ESGSelector{Descr: mapString(obj.attr, "descr")}
+		switch obj.class {
+		case "fvEPgSelector":
+			s.Kind = ESGSelectorKindEPG
+			s.Match = mapString(obj.attr, "matchEpgDn")
+		case "fvEPSelector":
+			s.Kind = ESGSelectorKindIP
+			s.Match = mapString(obj.attr, "matchExpression")
+		case "fvTagSelector":
+			s.Kind = ESGSelectorKindTag
+			s.Match = mapString(obj.attr, "matchKey")
+			s.Operator = mapString(obj.attr, "valueOperator")
+			s.Value = mapString(obj.attr, "matchValue")
+		default:
+			continue
+		}
+		list = append(list, s)
+	}
+
+	return list, nil
+}
+
+// ESGContractProvidedAdd attaches contract as provided by ESG.
+func (c *Client) ESGContractProvidedAdd(tenant, applicationProfile, esg, contract string) error {
+	j := fmt.Sprintf(`{"fvRsProv":{"attributes":{"tnVzBrCPName":"%s","status":"created,modified"}}}`, contract)
+	return c.esgChildAdd("ESGContractProvidedAdd", tenant, applicationProfile, esg, j)
+}
+
+// ESGContractProvidedDel detaches provided contract from ESG.
+func (c *Client) ESGContractProvidedDel(tenant, applicationProfile, esg, contract string) error {
+	return c.esgChildDel("ESGContractProvidedDel", tenant, applicationProfile, esg, "fvRsProv", "rsprov-"+contract)
+}
+
+// ESGContractProvidedList retrieves the list of contracts provided by ESG.
+func (c *Client) ESGContractProvidedList(tenant, applicationProfile, esg string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("ESGContractProvidedList", dnESG(tenant, applicationProfile, esg), "fvRsProv")
+}
+
+// ESGContractConsumedAdd attaches contract as consumed by ESG.
+func (c *Client) ESGContractConsumedAdd(tenant, applicationProfile, esg, contract string) error {
+	j := fmt.Sprintf(`{"fvRsCons":{"attributes":{"tnVzBrCPName":"%s","status":"created,modified"}}}`, contract)
+	return c.esgChildAdd("ESGContractConsumedAdd", tenant, applicationProfile, esg, j)
+}
+
+// ESGContractConsumedDel detaches consumed contract from ESG.
+func (c *Client) ESGContractConsumedDel(tenant, applicationProfile, esg, contract string) error {
+	return c.esgChildDel("ESGContractConsumedDel", tenant, applicationProfile, esg, "fvRsCons", "rscons-"+contract)
+}
+
+// ESGContractConsumedList retrieves the list of contracts consumed by ESG.
+func (c *Client) ESGContractConsumedList(tenant, applicationProfile, esg string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("ESGContractConsumedList", dnESG(tenant, applicationProfile, esg), "fvRsCons")
+}
@@ -3,6 +3,14 @@ package aci
 import (
 	"bytes"
 	"fmt"
+	"strconv"
+	"time"
+)
+
+// Export formats.
+const (
+	ExportFormatJSON = "json" // Export format. Configuration is exported as JSON.
+	ExportFormatXML  = "xml"  // Export format. Configuration is exported as XML.
 )
 
 func rnExportConfig(config string) string {
@@ -179,3 +187,86 @@ func (c *Client) ExportConfigurationRemoteLocationGet(config string) (map[string
 
 	return list[0], nil
 }
+
+// ExportConfigurationJobList retrieves the list of jobs executed for an export configuration.
+func (c *Client) ExportConfigurationJobList(config string) ([]ConfigJob, error) {
+	return c.configJobList("ExportConfigurationJobList", "fabric/"+rnExportConfig(config))
+}
+
+// ExportConfigurationRunWait executes the export configuration now and waits for the resulting job to finish.
+// The finished job is returned. Use ConfigJob.Success() to check the job result.
+// ConfigJob.FileName and ConfigJob.FileSize describe the exported file, and ConfigJob.Details holds the failure reason.
+func (c *Client) ExportConfigurationRunWait(config string, timeout time.Duration) (ConfigJob, error) {
+
+	me := "ExportConfigurationRunWait"
+
+	dn := "fabric/" + rnExportConfig(config)
+
+	jobs, errList := c.configJobList(me, dn)
+	if errList != nil {
+		return ConfigJob{}, errList
+	}
+
+	known := configJobKnown(jobs)
+
+	if errRun := c.ExportConfigurationRun(config); errRun != nil {
+		return ConfigJob{}, fmt.Errorf("%s: %v", me, errRun)
+	}
+
+	return c.configJobWait(me, dn, known, timeout)
+}
+
+// ExportConfigurationOneTimeRunWait creates (or updates) an export configuration without scheduler, executes it now and waits for the resulting job to finish.
+// If the export configuration already exists, its scheduler, if any, is detached.
+// format: ExportFormatJSON, ExportFormatXML, "" (empty means default)
+// targetDn restricts the export to a subtree. Example: "uni/tn-prod". Empty targetDn exports the whole configuration.
+// remoteLocation may be empty for snapshots, which are stored on APIC.
+func (c *Client) ExportConfigurationOneTimeRunWait(config, format, targetDn, remoteLocation string, snapshot bool, descr string, timeout time.Duration) (ConfigJob, error) {
+
+	me := "ExportConfigurationOneTimeRunWait"
+
+	rn := rnExportConfig(config)
+
+	dn := "fabric/" + rn
+
+	jobs, errList := c.configJobList(me, dn)
+	if errList != nil {
+		return ConfigJob{}, errList
+	}
+
+	known := configJobKnown(jobs)
+
+	api := "/api/node/mo/uni/" + dn + ".json"
+
+	url := c.getURL(api)
+
+	var attrFormat string
+	if format != "" {
+		attrFormat = fmt.Sprintf(`,"format":"%s"`, format)
+	}
+
+	var remotePath string
+	if remoteLocation != "" {
+		remotePath = fmt.Sprintf(`,{"configRsRemotePath":{"attributes":{"tnFileRemotePathName":"%s","status":"created,modified"}}}`,
+			remoteLocation)
+	}
+
+	// An empty tnTrigSchedPName detaches any scheduler previously attached to an existing export configuration.
+	j := fmt.Sprintf(`{"configExportP":{"attributes":{"dn":"uni/%s","name":"%s","descr":"%s"%s,"targetDn":"%s","snapshot":"%s","adminSt":"triggered","rn":"%s","status":"created,modified"},"children":[{"configRsExportScheduler":{"attributes":{"tnTrigSchedPName":"","status":"created,modified"}}}%s]}}`,
+		dn, config, descr, attrFormat, targetDn, strconv.FormatBool(snapshot), rn, remotePath)
+
+	c.debugf("%s: url=%s json=%s", me, url, j)
+
+	body, errPost := c.post(url, contentTypeJSON, bytes.NewBufferString(j))
+	if errPost != nil {
+		return ConfigJob{}, fmt.Errorf("%s: %v", me, errPost)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	if errReply := parseJSONError(body); errReply != nil {
+		return ConfigJob{}, fmt.Errorf("%s: %v", me, errReply)
+	}
+
+	return c.configJobWait(me, dn, known, timeout)
+}
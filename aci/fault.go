@@ -1,5 +1,96 @@
 package aci
 
+import (
+	"bytes"
+	"fmt"
+	"time"
+)
+
+// Fault severities.
+const (
+	FaultSeverityCritical = "critical"
+	FaultSeverityMajor    = "major"
+	FaultSeverityMinor    = "minor"
+	FaultSeverityWarning  = "warning"
+	FaultSeverityInfo     = "info"
+	FaultSeverityCleared  = "cleared"
+)
+
+// Fault holds an active fault (faultInst).
+type Fault struct {
+	Dn             string // Fault DN. The affected object DN is the fault DN without the last "/fault-CODE" element.
+	Code           string // Example: "F0467"
+	Severity       string // FaultSeverityCritical, FaultSeverityMajor, ...
+	Cause          string
+	Descr          string
+	Lc             string // Lifecycle: "raised", "soaking", "retaining", "raised-clearing", "soaking-clearing"
+	Ack            string // Acknowledged: "yes", "no"
+	Created        string
+	LastTransition string
+	Domain         string
+	Type           string
+	Subject        string
+}
+
+func faultFromAttributes(attr map[string]interface{}) Fault {
+	return Fault{
+		Dn:             mapString(attr, "dn"),
+		Code:           mapString(attr, "code"),
+		Severity:       mapString(attr, "severity"),
+		Cause:          mapString(attr, "cause"),
+		Descr:          mapString(attr, "descr"),
+		Lc:             mapString(attr, "lc"),
+		Ack:            mapString(attr, "ack"),
+		Created:        mapString(attr, "created"),
+		LastTransition: mapString(attr, "lastTransition"),
+		Domain:         mapString(attr, "domain"),
+		Type:           mapString(attr, "type"),
+		Subject:        mapString(attr, "subject"),
+	}
+}
+
+// FaultFilter selects faults for FaultQuery(). Empty fields do not restrict the query.
+type FaultFilter struct {
+	Severity  []string  // Any of these severities. Example: []string{aci.FaultSeverityCritical, aci.FaultSeverityMajor}
+	Dn        string    // Only faults under this DN subtree. Example: "uni/tn-prod" or "topology/pod-1/node-101"
+	Code      string    // Fault code. Example: "F0467"
+	Lifecycle string    // Lifecycle state: "raised", "soaking", "retaining", "raised-clearing", "soaking-clearing"
+	Ack       string    // Acknowledgement: "yes", "no"
+	Since     time.Time // Only faults created at or after this time.
+	Until     time.Time // Only faults created at or before this time.
+}
+
+// faultQueryFilter builds the query-target-filter expression for a FaultFilter.
+func faultQueryFilter(f FaultFilter) string {
+
+	key := "faultInst"
+
+	var severity []string
+	for _, s := range f.Severity {
+		severity = append(severity, queryEq(key, "severity", s))
+	}
+
+	var terms []string
+	terms = append(terms, queryOr(severity...))
+	if f.Code != "" {
+		terms = append(terms, queryEq(key, "code", f.Code))
+	}
+	if f.Lifecycle != "" {
+		terms = append(terms, queryEq(key, "lc", f.Lifecycle))
+	}
+	if f.Ack != "" {
+		terms = append(terms, queryEq(key, "ack", f.Ack))
+	}
+	if !f.Since.IsZero() {
+		terms = append(terms, queryGe(key, "created", f.Since))
+	}
+	if !f.Until.IsZero() {
+		terms = append(terms, queryLe(key, "created", f.Until))
+	}
+
+	return queryAnd(terms...)
+}
+
 // FaultList retrieves the list of faults in the fabric.
 func (c *Client) FaultList() ([]map[string]interface{}, error) {
 
@@ -20,3 +111,73 @@ func (c *Client) FaultList() ([]map[string]interface{}, error) {
 
 	return jsonImdataAttributes(c, body, key, "FaultList")
 }
+
+// FaultQuery retrieves the faults selected by the filter, most recent first.
+func (c *Client) FaultQuery(f FaultFilter) ([]Fault, error) {
+
+	me := "FaultQuery"
+
+	key := "faultInst"
+
+	filter := queryFilter(faultQueryFilter(f))
+	order := "order-by=" + key + ".created|desc"
+
+	var api string
+	if f.Dn == "" {
+		api = "/api/node/class/" + key + ".json" + queryOptions(filter, order)
+	} else {
+		api = "/api/node/mo/" + f.Dn + ".json" + queryOptions("query-target=subtree", "target-subtree-class="+key, filter, order)
+	}
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	attrs, errAttr := jsonImdataAttributes(c, body, key, me)
+	if errAttr != nil {
+		return nil, fmt.Errorf("%s: %v", me, errAttr)
+	}
+
+	faults := make([]Fault, 0, len(attrs))
+	for _, attr := range attrs {
+		faults = append(faults, faultFromAttributes(attr))
+	}
+
+	return faults, nil
+}
+
+// FaultAck acknowledges (or clears the acknowledgement of) a fault, given by its DN.
+func (c *Client) FaultAck(dn string, ack bool) error {
+
+	me := "FaultAck"
+
+	api := "/api/node/mo/" + dn + ".json"
+
+	url := c.getURL(api)
+
+	value := "no"
+	if ack {
+		value = "yes"
+	}
+
+	j := fmt.Sprintf(`{"faultInst":{"attributes":{"dn":"%s","ack":"%s"}}}`,
+		dn, value)
+
+	c.debugf("%s: url=%s json=%s", me, url, j)
+
+	body, errPost := c.post(url, contentTypeJSON, bytes.NewBufferString(j))
+	if errPost != nil {
+		return fmt.Errorf("%s: %v", me, errPost)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return parseJSONError(body)
+}
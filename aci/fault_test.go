@@ -0,0 +1,39 @@
+package aci
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFaultQueryFilter(t *testing.T) {
+	faultQueryFilterTest(t, FaultFilter{}, ``)
+	faultQueryFilterTest(t, FaultFilter{Dn: "uni/tn-prod"}, ``)
+	faultQueryFilterTest(t, FaultFilter{Severity: []string{FaultSeverityCritical}}, `eq(faultInst.severity,"critical")`)
+	faultQueryFilterTest(t, FaultFilter{Severity: []string{FaultSeverityCritical, FaultSeverityMajor}},
+		`or(eq(faultInst.severity,"critical"),eq(faultInst.severity,"major"))`)
+	faultQueryFilterTest(t, FaultFilter{Severity: []string{FaultSeverityCritical}, Code: "F0467", Ack: "no"},
+		`and(eq(faultInst.severity,"critical"),eq(faultInst.code,"F0467"),eq(faultInst.ack,"no"))`)
+
+	since := time.Date(2017, 5, 12, 10, 4, 33, 0, time.UTC)
+	faultQueryFilterTest(t, FaultFilter{Lifecycle: "raised", Since: since},
+		`and(eq(faultInst.lc,"raised"),ge(faultInst.created,"2017-05-12T10:04:33.000+00:00"))`)
+}
+
+func faultQueryFilterTest(t *testing.T, f FaultFilter, want string) {
+	if got := faultQueryFilter(f); got != want {
+		t.Errorf("filter=%v want=%s got=%s", f, want, got)
+	}
+}
+
+func TestQueryOptions(t *testing.T) {
+	queryOptionsTest(t, nil, "")
+	queryOptionsTest(t, []string{"", ""}, "")
+	queryOptionsTest(t, []string{"a=1", "", "b=2"}, "?a=1&b=2")
+	queryOptionsTest(t, []string{queryFilter(`eq(fvTenant.name,"a b")`)}, "?query-target-filter=eq%28fvTenant.name%2C%22a+b%22%29")
+}
+
+func queryOptionsTest(t *testing.T, options []string, want string) {
+	if got := queryOptions(options...); got != want {
+		t.Errorf("options=%v want=%s got=%s", options, want, got)
+	}
+}
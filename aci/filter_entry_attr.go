@@ -0,0 +1,249 @@
+package aci
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Ether types for FilterEntry.EtherType.
+const (
+	EtherTypeUnspecified = "unspecified"
+	EtherTypeIP          = "ip"
+	EtherTypeIPv4        = "ipv4"
+	EtherTypeIPv6        = "ipv6"
+	EtherTypeARP         = "arp"
+	EtherTypeFCoE        = "fcoe"
+	EtherTypeMPLS        = "mpls_ucast"
+	EtherTypeMACSec      = "mac_security"
+	EtherTypeTrill       = "trill"
+)
+
+// IP protocols for FilterEntry.Protocol.
+const (
+	IPProtoUnspecified = "unspecified"
+	IPProtoICMP        = "icmp"
+	IPProtoICMPv6      = "icmpv6"
+	IPProtoIGMP        = "igmp"
+	IPProtoTCP         = "tcp"
+	IPProtoUDP         = "udp"
+	IPProtoEIGRP       = "eigrp"
+	IPProtoOSPF        = "ospfigp"
+	IPProtoPIM         = "pim"
+	IPProtoL2TP        = "l2tp"
+)
+
+// TCP session rules for FilterEntry.TCPRules.
+const (
+	TCPRuleEstablished = "est"
+	TCPRuleSyn         = "syn"
+	TCPRuleAck         = "ack"
+	TCPRuleFin         = "fin"
+	TCPRuleRst         = "rst"
+)
+
+// ARP opcodes for FilterEntry.ARPOpcode.
+const (
+	ARPOpcodeUnspecified = "unspecified"
+	ARPOpcodeRequest     = "req"
+	ARPOpcodeReply       = "reply"
+)
+
+// ICMPv4 types for FilterEntry.ICMPv4Type.
+const (
+	ICMPv4TypeUnspecified  = "unspecified"
+	ICMPv4TypeEchoRequest  = "echo"
+	ICMPv4TypeEchoReply    = "echo-rep"
+	ICMPv4TypeUnreachable  = "dst-unreach"
+	ICMPv4TypeSourceQuench = "src-quench"
+	ICMPv4TypeTimeExceeded = "time-exceeded"
+)
+
+// ICMPv6 types for FilterEntry.ICMPv6Type.
+const (
+	ICMPv6TypeUnspecified           = "unspecified"
+	ICMPv6TypeEchoRequest           = "echo-req"
+	ICMPv6TypeEchoReply             = "echo-rep"
+	ICMPv6TypeUnreachable           = "dst-unreach"
+	ICMPv6TypeTimeExceeded          = "time-exceeded"
+	ICMPv6TypeNeighborSolicitation  = "nbr-solicit"
+	ICMPv6TypeNeighborAdvertisement = "nbr-advert"
+)
+
+// Named ports accepted by APIC in place of port numbers.
+const (
+	PortUnspecified = "unspecified"
+	PortFTPData     = "ftpData"
+	PortSMTP        = "smtp"
+	PortDNS         = "dns"
+	PortHTTP        = "http"
+	PortPOP3        = "pop3"
+	PortHTTPS       = "https"
+	PortRTSP        = "rtsp"
+)
+
+var filterPortNames = map[string]int{
+	PortUnspecified: 0,
+	PortFTPData:     20,
+	PortSMTP:        25,
+	PortDNS:         53,
+	PortHTTP:        80,
+	PortPOP3:        110,
+	PortHTTPS:       443,
+	PortRTSP:        554,
+}
+
+// FilterPortNumber converts a port, either named or numeric, to its number.
+// "unspecified" and "" are converted to 0. Example: "https" => 443, "8080" => 8080
+func FilterPortNumber(port string) (int, error) {
+	if port == "" {
+		return 0, nil
+	}
+	if n, found := filterPortNames[port]; found {
+		return n, nil
+	}
+	n, errConv := strconv.Atoi(port)
+	if errConv != nil || n < 0 || n > 65535 {
+		return 0, fmt.Errorf("bad port: '%s'", port)
+	}
+	return n, nil
+}
+
+// FilterEntry holds the settings of a filter entry (vzEntry).
+// Empty strings, nil TCPRules and nil Stateful/ApplyToFrag are not sent to APIC, leaving the APIC default (or the current value, on modify).
+// A non-nil empty TCPRules clears the TCP session rules.
+type FilterEntry struct {
+	Name        string
+	Descr       string
+	EtherType   string   // EtherTypeIP, EtherTypeIPv4, EtherTypeARP, ...
+	Protocol    string   // IPProtoTCP, IPProtoUDP, IPProtoICMP, ... Requires EtherType IP/IPv4/IPv6.
+	SrcPortFrom string   // Port number or name. Example: "1024", PortHTTPS
+	SrcPortTo   string   // Port number or name.
+	DstPortFrom string   // Port number or name.
+	DstPortTo   string   // Port number or name.
+	Stateful    *bool    // Stateful TCP (ACK bit checked for return traffic).
+	TCPRules    []string // TCPRuleEstablished, TCPRuleSyn, ... Requires Protocol TCP.
+	ARPOpcode   string   // ARPOpcodeRequest, ARPOpcodeReply. Requires EtherType ARP.
+	ICMPv4Type  string   // ICMPv4TypeEchoRequest, ... Requires Protocol ICMP.
+	ICMPv6Type  string   // ICMPv6TypeEchoRequest, ... Requires Protocol ICMPv6.
+	ApplyToFrag *bool    // Match only fragments. Ports must be unspecified.
+	MatchDSCP   string   // Example: "unspecified", "EF", "AF11", "CS3"
+}
+
+// attrYes: "yes" => true, "no" => false
+func attrYes(attr map[string]interface{}, name string) *bool {
+	b := mapString(attr, name) == "yes"
+	return &b
+}
+
+func filterEntryFromAttributes(attr map[string]interface{}) FilterEntry {
+	return FilterEntry{
+		Name:        mapString(attr, "name"),
+		Descr:       mapString(attr, "descr"),
+		EtherType:   mapString(attr, "etherT"),
+		Protocol:    mapString(attr, "prot"),
+		SrcPortFrom: mapString(attr, "sFromPort"),
+		SrcPortTo:   mapString(attr, "sToPort"),
+		DstPortFrom: mapString(attr, "dFromPort"),
+		DstPortTo:   mapString(attr, "dToPort"),
+		Stateful:    attrYes(attr, "stateful"),
+		TCPRules:    splitFlags(mapString(attr, "tcpRules")),
+		ARPOpcode:   mapString(attr, "arpOpc"),
+		ICMPv4Type:  mapString(attr, "icmpv4T"),
+		ICMPv6Type:  mapString(attr, "icmpv6T"),
+		ApplyToFrag: attrYes(attr, "applyToFrag"),
+		MatchDSCP:   mapString(attr, "matchDscp"),
+	}
+}
+
+// filterEntryJSON builds the vzEntry object for an entry, given by dn.
+func filterEntryJSON(dn, status string, e FilterEntry) string {
+
+	attrs := optionalAttr("descr", e.Descr) +
+		optionalAttr("etherT", e.EtherType) +
+		optionalAttr("prot", e.Protocol) +
+		optionalAttr("sFromPort", e.SrcPortFrom) +
+		optionalAttr("sToPort", e.SrcPortTo) +
+		optionalAttr("dFromPort", e.DstPortFrom) +
+		optionalAttr("dToPort", e.DstPortTo) +
+		optionalAttr("arpOpc", e.ARPOpcode) +
+		optionalAttr("icmpv4T", e.ICMPv4Type) +
+		optionalAttr("icmpv6T", e.ICMPv6Type) +
+		optionalAttr("matchDscp", e.MatchDSCP)
+
+	if e.TCPRules != nil {
+		attrs += fmt.Sprintf(`,"tcpRules":"%s"`, strings.Join(e.TCPRules, ","))
+	}
+	if e.Stateful != nil {
+		attrs += fmt.Sprintf(`,"stateful":"%s"`, yesNo(*e.Stateful))
+	}
+	if e.ApplyToFrag != nil {
+		attrs += fmt.Sprintf(`,"applyToFrag":"%s"`, yesNo(*e.ApplyToFrag))
+	}
+
+	return fmt.Sprintf(`{"vzEntry":{"attributes":{"dn":"uni/%s","name":"%s"%s,"status":"%s"}}}`,
+		dn, e.Name, attrs, status)
+}
+
+// FilterEntryCreate creates a new filter entry with all settings from e.
+// Unlike FilterEntryAdd, it fails if the entry already exists.
+func (c *Client) FilterEntryCreate(tenant, filter string, e FilterEntry) error {
+	dn := dnFilterEntry(tenant, filter, e.Name)
+	return c.moPost("FilterEntryCreate", dn, filterEntryJSON(dn, "created", e))
+}
+
+// FilterEntryModify updates an existing filter entry, given by e.Name.
+// Empty string fields, nil TCPRules and nil Stateful/ApplyToFrag in e are left unchanged.
+// Set TCPRules to an empty non-nil slice to clear the TCP session rules.
+func (c *Client) FilterEntryModify(tenant, filter string, e FilterEntry) error {
+	dn := dnFilterEntry(tenant, filter, e.Name)
+	return c.moPost("FilterEntryModify", dn, filterEntryJSON(dn, "modified", e))
+}
+
+// FilterEntryGet retrieves the settings of a filter entry.
+func (c *Client) FilterEntryGet(tenant, filter, entry string) (FilterEntry, error) {
+
+	me := "FilterEntryGet"
+
+	key := "vzEntry"
+
+	api := "/api/node/mo/uni/" + dnFilterEntry(tenant, filter, entry) + ".json"
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return FilterEntry{}, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	attrs, errAttr := jsonImdataAttributes(c, body, key, me)
+	if errAttr != nil {
+		return FilterEntry{}, fmt.Errorf("%s: %v", me, errAttr)
+	}
+
+	if len(attrs) != 1 {
+		return FilterEntry{}, fmt.Errorf("%s: bad attr count=%d", me, len(attrs))
+	}
+
+	return filterEntryFromAttributes(attrs[0]), nil
+}
+
+// FilterEntrySettingsList retrieves the settings of all entries in a filter.
+func (c *Client) FilterEntrySettingsList(tenant, filter string) ([]FilterEntry, error) {
+
+	attrs, errList := c.moChildrenAttributes("FilterEntrySettingsList", dnFilter(tenant, filter), "vzEntry")
+	if errList != nil {
+		return nil, errList
+	}
+
+	list := make([]FilterEntry, 0, len(attrs))
+	for _, attr := range attrs {
+		list = append(list, filterEntryFromAttributes(attr))
+	}
+
+	return list, nil
+}
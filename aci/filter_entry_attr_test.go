@@ -0,0 +1,96 @@
+package aci
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFilterPortNumber(t *testing.T) {
+	filterPortNumberTest(t, "", 0, false)
+	filterPortNumberTest(t, "unspecified", 0, false)
+	filterPortNumberTest(t, "ftpData", 20, false)
+	filterPortNumberTest(t, "smtp", 25, false)
+	filterPortNumberTest(t, "dns", 53, false)
+	filterPortNumberTest(t, "http", 80, false)
+	filterPortNumberTest(t, "pop3", 110, false)
+	filterPortNumberTest(t, "https", 443, false)
+	filterPortNumberTest(t, "rtsp", 554, false)
+	filterPortNumberTest(t, "8080", 8080, false)
+	filterPortNumberTest(t, "ssh", 0, true)
+	filterPortNumberTest(t, "70000", 0, true)
+	filterPortNumberTest(t, "-1", 0, true)
+}
+
+func filterPortNumberTest(t *testing.T, port string, want int, wantErr bool) {
+	got, err := FilterPortNumber(port)
+	if (err != nil) != wantErr {
+		t.Errorf("port=%s wantErr=%v err=%v", port, wantErr, err)
+		return
+	}
+	if got != want {
+		t.Errorf("port=%s want=%d got=%d", port, want, got)
+	}
+}
+
+func TestFilterEntryJSON(t *testing.T) {
+	stateful := true
+	e := FilterEntry{
+		Name:        "e1",
+		EtherType:   EtherTypeIP,
+		Protocol:    IPProtoTCP,
+		DstPortFrom: PortHTTPS,
+		DstPortTo:   PortHTTPS,
+		Stateful:    &stateful,
+		TCPRules:    []string{TCPRuleAck, TCPRuleRst},
+	}
+
+	attr := filterEntryJSONAttributes(t, filterEntryJSON("tn-t1/flt-f1/e-e1", "created", e))
+
+	got := filterEntryFromAttributes(attr)
+	if got.Name != e.Name || got.Protocol != e.Protocol || got.DstPortFrom != e.DstPortFrom || !*got.Stateful || *got.ApplyToFrag || len(got.TCPRules) != 2 {
+		t.Errorf("unexpected entry: %+v", got)
+	}
+	if got.ICMPv4Type != "" {
+		t.Errorf("unexpected icmpv4T: %s", got.ICMPv4Type)
+	}
+	if _, found := attr["applyToFrag"]; found {
+		t.Errorf("unexpected applyToFrag: %v", attr)
+	}
+}
+
+func TestFilterEntryModifyJSON(t *testing.T) {
+	current := map[string]interface{}{"name": "e1", "etherT": "ip", "prot": "tcp", "dFromPort": "http", "dToPort": "http", "stateful": "yes", "tcpRules": "est", "applyToFrag": "no"}
+
+	// port-only modify keeps stateful and TCP rules
+	got := filterEntryModifyTest(t, current, FilterEntry{Name: "e1", DstPortFrom: PortHTTPS, DstPortTo: PortHTTPS})
+	if got.DstPortFrom != PortHTTPS || !*got.Stateful || len(got.TCPRules) != 1 || got.Protocol != IPProtoTCP {
+		t.Errorf("port-only modify: unexpected entry: %+v", got)
+	}
+
+	// explicit updates
+	stateful := false
+	got = filterEntryModifyTest(t, current, FilterEntry{Name: "e1", Stateful: &stateful, TCPRules: []string{}})
+	if *got.Stateful || len(got.TCPRules) != 0 || got.DstPortFrom != PortHTTP {
+		t.Errorf("clear modify: unexpected entry: %+v", got)
+	}
+}
+
+// filterEntryModifyTest applies the modify payload over the current attributes, as APIC does.
+func filterEntryModifyTest(t *testing.T, current map[string]interface{}, e FilterEntry) FilterEntry {
+	merged := map[string]interface{}{}
+	for k, v := range current {
+		merged[k] = v
+	}
+	for k, v := range filterEntryJSONAttributes(t, filterEntryJSON("tn-t1/flt-f1/e-e1", "modified", e)) {
+		merged[k] = v
+	}
+	return filterEntryFromAttributes(merged)
+}
+
+func filterEntryJSONAttributes(t *testing.T, j string) map[string]interface{} {
+	var obj map[string]map[string]map[string]interface{}
+	if errUnmarshal := json.Unmarshal([]byte(j), &obj); errUnmarshal != nil {
+		t.Fatalf("invalid json: %v: %s", errUnmarshal, j)
+	}
+	return obj["vzEntry"]["attributes"]
+}
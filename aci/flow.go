@@ -0,0 +1,433 @@
+package aci
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FlowEPG holds the policy settings of an EPG relevant to flow analysis.
+// Contracts, contract interfaces, taboo contracts and the VRF are given by their DNs, as resolved by APIC.
+// Example: "uni/tn-common/brc-default"
+type FlowEPG struct {
+	Tenant               string
+	ApplicationProfile   string
+	Name                 string
+	Vrf                  string // VRF DN. Example: "uni/tn-t1/ctx-vrf1"
+	PreferredGroupMember bool
+	IntraEPGIsolation    bool
+	Provided             []string // DNs of contracts provided by the EPG.
+	Consumed             []string // DNs of contracts consumed by the EPG.
+	ConsumedInterfaces   []string // DNs of contract interfaces consumed by the EPG. Example: "uni/tn-t1/cif-web"
+	Taboos               []string // DNs of taboo contracts protecting the EPG.
+}
+
+// FlowVrf holds the policy settings of a VRF relevant to flow analysis.
+type FlowVrf struct {
+	Dn             string
+	Enforced       bool     // Policy control is enforced.
+	PreferredGroup bool     // Preferred group is enabled.
+	AnyProvided    []string // DNs of contracts provided by vzAny.
+	AnyConsumed    []string // DNs of contracts consumed by vzAny.
+}
+
+// FlowSubject holds a contract subject with its filters, given by their DNs.
+// For taboo contract subjects, Filters holds the deny filters.
+type FlowSubject struct {
+	Name                string
+	ApplyBothDirections bool
+	ReverseFilterPorts  bool
+	Filters             []string // Filters applied to both directions.
+	InputFilters        []string // Filters applied from consumer to provider (vzInTerm).
+	OutputFilters       []string // Filters applied from provider to consumer (vzOutTerm).
+}
+
+// FlowContract holds a contract, or a taboo contract, with its subjects.
+type FlowContract struct {
+	Dn       string
+	Scope    string // ContractScopeContext, ContractScopeTenant, ContractScopeApplicationProfile, ContractScopeGlobal
+	Subjects []FlowSubject
+}
+
+// FlowPolicy holds the policy relevant to traffic between two EPGs.
+// It is retrieved by FlowPolicyGet and can be serialized as JSON, then evaluated offline by Analyze.
+type FlowPolicy struct {
+	Src        FlowEPG
+	Dst        FlowEPG
+	Vrfs       map[string]FlowVrf       // VRF DN => VRF.
+	Contracts  map[string]FlowContract  // Contract DN => contract.
+	Interfaces map[string]string        // Contract interface DN => exported contract DN.
+	Taboos     map[string]FlowContract  // Taboo contract DN => taboo contract.
+	Filters    map[string][]FilterEntry // Filter DN => entries.
+	Unresolved []string                 // Relations not resolved by APIC. They have no effect, but may explain a DENY.
+}
+
+// dnSplit: "uni/tn-t1/brc-web", "brc-" => "t1", "web"
+func dnSplit(dn, prefix string) (tenant, name string, err error) {
+	rns := strings.Split(strings.TrimPrefix(dn, "uni/"), "/")
+	if len(rns) != 2 || !strings.HasPrefix(rns[0], "tn-") || !strings.HasPrefix(rns[1], prefix) {
+		return "", "", fmt.Errorf("bad dn: '%s' expecting: uni/tn-tenant/%sname", dn, prefix)
+	}
+	return stripPrefix(rns[0], "tn-"), stripPrefix(rns[1], prefix), nil
+}
+
+// relationTargets collects the resolved targets (tDn) of relations.
+// Relations without target are reported as unresolved.
+func (p *FlowPolicy) relationTargets(attrs []map[string]interface{}) []string {
+	var targets []string
+	for _, attr := range attrs {
+		target := mapString(attr, "tDn")
+		if target == "" {
+			p.Unresolved = append(p.Unresolved, mapString(attr, "dn"))
+			continue
+		}
+		targets = append(targets, target)
+	}
+	return targets
+}
+
+// flowEPGGet retrieves the settings and relations of an application EPG.
+func (c *Client) flowEPGGet(me string, p *FlowPolicy, tenant, applicationProfile, epg string) (FlowEPG, error) {
+
+	e, errEPG := c.ApplicationEPGGet(tenant, applicationProfile, epg)
+	if errEPG != nil {
+		return FlowEPG{}, fmt.Errorf("%s: %v", me, errEPG)
+	}
+
+	dnE := dnAEPG(tenant, applicationProfile, epg)
+
+	bds, errBD := c.moChildrenAttributes(me, dnE, "fvRsBd")
+	if errBD != nil {
+		return FlowEPG{}, errBD
+	}
+	bd := p.relationTargets(bds)
+	if len(bd) != 1 {
+		return FlowEPG{}, fmt.Errorf("%s: bridge domain not resolved for epg=%s", me, dnE)
+	}
+
+	vrfs, errVrf := c.moChildrenAttributes(me, strings.TrimPrefix(bd[0], "uni/"), "fvRsCtx")
+	if errVrf != nil {
+		return FlowEPG{}, errVrf
+	}
+	vrf := p.relationTargets(vrfs)
+	if len(vrf) != 1 {
+		return FlowEPG{}, fmt.Errorf("%s: VRF not resolved for bridge domain=%s", me, bd[0])
+	}
+
+	prov, errProv := c.EPGContractProvidedList(tenant, applicationProfile, epg)
+	if errProv != nil {
+		return FlowEPG{}, fmt.Errorf("%s: %v", me, errProv)
+	}
+
+	cons, errCons := c.EPGContractConsumedList(tenant, applicationProfile, epg)
+	if errCons != nil {
+		return FlowEPG{}, fmt.Errorf("%s: %v", me, errCons)
+	}
+
+	ifaces, errIfaces := c.EPGContractInterfaceConsumedList(tenant, applicationProfile, epg)
+	if errIfaces != nil {
+		return FlowEPG{}, errIfaces
+	}
+
+	taboos, errTaboos := c.EPGTabooContractList(tenant, applicationProfile, epg)
+	if errTaboos != nil {
+		return FlowEPG{}, errTaboos
+	}
+
+	return FlowEPG{
+		Tenant:               tenant,
+		ApplicationProfile:   applicationProfile,
+		Name:                 epg,
+		Vrf:                  vrf[0],
+		PreferredGroupMember: e.PreferredGroupMember,
+		IntraEPGIsolation:    e.IntraEPGIsolation,
+		Provided:             p.relationTargets(prov),
+		Consumed:             p.relationTargets(cons),
+		ConsumedInterfaces:   p.relationTargets(ifaces),
+		Taboos:               p.relationTargets(taboos),
+	}, nil
+}
+
+// flowVrfGet retrieves the enforcement, preferred group and vzAny contracts of a VRF.
+func (c *Client) flowVrfGet(me string, p *FlowPolicy, dn string) error {
+
+	if _, found := p.Vrfs[dn]; found {
+		return nil
+	}
+
+	tenant, vrf, errDn := dnSplit(dn, "ctx-")
+	if errDn != nil {
+		return fmt.Errorf("%s: %v", me, errDn)
+	}
+
+	v, errGet := c.VrfGet(tenant, vrf)
+	if errGet != nil {
+		return fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	prov, errProv := c.VrfAnyContractProvidedList(tenant, vrf)
+	if errProv != nil {
+		return errProv
+	}
+
+	cons, errCons := c.VrfAnyContractConsumedList(tenant, vrf)
+	if errCons != nil {
+		return errCons
+	}
+
+	p.Vrfs[dn] = FlowVrf{
+		Dn:             dn,
+		Enforced:       v.Enforced,
+		PreferredGroup: v.PreferredGroup,
+		AnyProvided:    p.relationTargets(prov),
+		AnyConsumed:    p.relationTargets(cons),
+	}
+
+	return nil
+}
+
+// flowSubjectGet retrieves the direction and filters of a contract subject.
+func (c *Client) flowSubjectGet(me string, p *FlowPolicy, tenant, contract string, attr map[string]interface{}) (FlowSubject, error) {
+
+	rev := mapString(attr, "revFltPorts")
+
+	s := FlowSubject{
+		Name:               mapString(attr, "name"),
+		ReverseFilterPorts: rev == "yes" || rev == "true",
+	}
+
+	both, errBoth := c.SubjectApplyBothDirections(tenant, contract, s.Name)
+	if errBoth != nil {
+		return FlowSubject{}, fmt.Errorf("%s: %v", me, errBoth)
+	}
+
+	s.ApplyBothDirections = both
+
+	if both {
+		filters, errList := c.SubjectFilterBothList(tenant, contract, s.Name)
+		if errList != nil {
+			return FlowSubject{}, fmt.Errorf("%s: %v", me, errList)
+		}
+		s.Filters = p.relationTargets(filters)
+		return s, nil
+	}
+
+	in, errIn := c.SubjectFilterInputList(tenant, contract, s.Name)
+	if errIn != nil {
+		return FlowSubject{}, fmt.Errorf("%s: %v", me, errIn)
+	}
+
+	out, errOut := c.SubjectFilterOutputList(tenant, contract, s.Name)
+	if errOut != nil {
+		return FlowSubject{}, fmt.Errorf("%s: %v", me, errOut)
+	}
+
+	s.InputFilters = p.relationTargets(in)
+	s.OutputFilters = p.relationTargets(out)
+
+	return s, nil
+}
+
+// flowContractGet retrieves the scope and subjects of a contract.
+func (c *Client) flowContractGet(me string, p *FlowPolicy, dn string) error {
+
+	if _, found := p.Contracts[dn]; found {
+		return nil
+	}
+
+	tenant, contract, errDn := dnSplit(dn, "brc-")
+	if errDn != nil {
+		return fmt.Errorf("%s: %v", me, errDn)
+	}
+
+	settings, errGet := c.ContractGet(tenant, contract)
+	if errGet != nil {
+		return fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	subjects, errList := c.ContractSubjectList(tenant, contract)
+	if errList != nil {
+		return fmt.Errorf("%s: %v", me, errList)
+	}
+
+	fc := FlowContract{Dn: dn, Scope: settings.Scope}
+
+	for _, attr := range subjects {
+		s, errSubj := c.flowSubjectGet(me, p, tenant, contract, attr)
+		if errSubj != nil {
+			return errSubj
+		}
+		fc.Subjects = append(fc.Subjects, s)
+	}
+
+	p.Contracts[dn] = fc
+
+	return nil
+}
+
+// flowTabooGet retrieves the subjects and deny filters of a taboo contract.
+func (c *Client) flowTabooGet(me string, p *FlowPolicy, dn string) error {
+
+	if _, found := p.Taboos[dn]; found {
+		return nil
+	}
+
+	tenant, taboo, errDn := dnSplit(dn, "taboo-")
+	if errDn != nil {
+		return fmt.Errorf("%s: %v", me, errDn)
+	}
+
+	subjects, errList := c.TabooSubjectList(tenant, taboo)
+	if errList != nil {
+		return errList
+	}
+
+	fc := FlowContract{Dn: dn}
+
+	for _, attr := range subjects {
+		name := mapString(attr, "name")
+		filters, errFilters := c.TabooSubjectFilterList(tenant, taboo, name)
+		if errFilters != nil {
+			return errFilters
+		}
+		fc.Subjects = append(fc.Subjects, FlowSubject{Name: name, Filters: p.relationTargets(filters)})
+	}
+
+	p.Taboos[dn] = fc
+
+	return nil
+}
+
+// flowInterfaceGet resolves a contract interface to the contract it exports.
+func (c *Client) flowInterfaceGet(me string, p *FlowPolicy, dn string) error {
+
+	if _, found := p.Interfaces[dn]; found {
+		return nil
+	}
+
+	tenant, iface, errDn := dnSplit(dn, "cif-")
+	if errDn != nil {
+		return fmt.Errorf("%s: %v", me, errDn)
+	}
+
+	contract, errGet := c.ContractInterfaceContractGet(tenant, iface)
+	if errGet != nil {
+		return errGet
+	}
+
+	p.Interfaces[dn] = contract
+
+	if contract == "" {
+		p.Unresolved = append(p.Unresolved, dn)
+		return nil
+	}
+
+	return c.flowContractGet(me, p, contract)
+}
+
+// flowFiltersGet retrieves the entries of all filters referenced by contracts and taboo contracts.
+func (c *Client) flowFiltersGet(me string, p *FlowPolicy) error {
+	for _, contracts := range []map[string]FlowContract{p.Contracts, p.Taboos} {
+		for _, fc := range contracts {
+			for _, s := range fc.Subjects {
+				for _, dn := range sortedNames(s.Filters, s.InputFilters, s.OutputFilters) {
+					if _, found := p.Filters[dn]; found {
+						continue
+					}
+					tenant, filter, errDn := dnSplit(dn, "flt-")
+					if errDn != nil {
+						return fmt.Errorf("%s: %v", me, errDn)
+					}
+					entries, errEntries := c.FilterEntrySettingsList(tenant, filter)
+					if errEntries != nil {
+						return fmt.Errorf("%s: %v", me, errEntries)
+					}
+					p.Filters[dn] = entries
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// FlowPolicyGet retrieves the policy relevant to traffic between two application EPGs:
+// EPG contracts, consumed contract interfaces, taboo contracts, vzAny contracts, preferred group membership,
+// contract subjects and filter entries.
+// Relations are followed through the targets resolved by APIC, so objects in tenant common are included.
+// Use FlowPolicy.Analyze to evaluate flows offline against the retrieved policy.
+func (c *Client) FlowPolicyGet(srcTenant, srcApplicationProfile, srcEPG, dstTenant, dstApplicationProfile, dstEPG string) (FlowPolicy, error) {
+
+	me := "FlowPolicyGet"
+
+	p := FlowPolicy{
+		Vrfs:       map[string]FlowVrf{},
+		Contracts:  map[string]FlowContract{},
+		Interfaces: map[string]string{},
+		Taboos:     map[string]FlowContract{},
+		Filters:    map[string][]FilterEntry{},
+	}
+
+	src, errSrc := c.flowEPGGet(me, &p, srcTenant, srcApplicationProfile, srcEPG)
+	if errSrc != nil {
+		return FlowPolicy{}, errSrc
+	}
+
+	dst, errDst := c.flowEPGGet(me, &p, dstTenant, dstApplicationProfile, dstEPG)
+	if errDst != nil {
+		return FlowPolicy{}, errDst
+	}
+
+	p.Src = src
+	p.Dst = dst
+
+	for _, dn := range sortedNames([]string{src.Vrf, dst.Vrf}) {
+		if errVrf := c.flowVrfGet(me, &p, dn); errVrf != nil {
+			return FlowPolicy{}, errVrf
+		}
+	}
+
+	contracts := [][]string{src.Provided, src.Consumed, dst.Provided, dst.Consumed}
+	for _, v := range p.Vrfs {
+		contracts = append(contracts, v.AnyProvided, v.AnyConsumed)
+	}
+
+	for _, dn := range sortedNames(contracts...) {
+		if errContract := c.flowContractGet(me, &p, dn); errContract != nil {
+			return FlowPolicy{}, errContract
+		}
+	}
+
+	for _, dn := range sortedNames(src.ConsumedInterfaces, dst.ConsumedInterfaces) {
+		if errIface := c.flowInterfaceGet(me, &p, dn); errIface != nil {
+			return FlowPolicy{}, errIface
+		}
+	}
+
+	for _, dn := range sortedNames(src.Taboos, dst.Taboos) {
+		if errTaboo := c.flowTabooGet(me, &p, dn); errTaboo != nil {
+			return FlowPolicy{}, errTaboo
+		}
+	}
+
+	if errFilters := c.flowFiltersGet(me, &p); errFilters != nil {
+		return FlowPolicy{}, errFilters
+	}
+
+	return p, nil
+}
+
+// sortedNames returns the sorted union of name lists, without duplicates.
+func sortedNames(lists ...[]string) []string {
+	set := map[string]bool{}
+	for _, list := range lists {
+		for _, name := range list {
+			set[name] = true
+		}
+	}
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
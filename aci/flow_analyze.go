@@ -0,0 +1,349 @@
+package aci
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Flow directions reported by FlowVerdict.Direction.
+const (
+	FlowDirectionConsumerToProvider = "consumer-to-provider"
+	FlowDirectionProviderToConsumer = "provider-to-consumer"
+)
+
+// Flow describes traffic from the source EPG to the destination EPG of a FlowPolicy.
+type Flow struct {
+	EtherType   string // EtherTypeIPv4, EtherTypeIPv6, EtherTypeARP. Empty means EtherTypeIPv4.
+	Protocol    string // IPProtoTCP, IPProtoUDP, IPProtoICMP, ...
+	SrcPort     int
+	DstPort     int
+	ICMPType    string // ICMPv4Type* or ICMPv6Type*. Empty matches only entries with unspecified ICMP type.
+	Established bool   // TCP segment of an established session (ACK set). False means a new connection (SYN).
+}
+
+// FlowVerdict holds the result of a flow analysis.
+type FlowVerdict struct {
+	Allowed   bool
+	Reason    string // Human-readable explanation, including session state and unresolved relation caveats.
+	Contract  string // DN of matched contract or taboo contract, if any.
+	Subject   string // Matched subject, if any.
+	Filter    string // DN of matched filter, if any.
+	Entry     string // Matched filter entry, if any.
+	Direction string // FlowDirectionConsumerToProvider, FlowDirectionProviderToConsumer
+}
+
+func (f Flow) String() string {
+	switch f.Protocol {
+	case IPProtoTCP:
+		if f.Established {
+			return fmt.Sprintf("%s %d->%d established", f.Protocol, f.SrcPort, f.DstPort)
+		}
+		return fmt.Sprintf("%s %d->%d", f.Protocol, f.SrcPort, f.DstPort)
+	case IPProtoUDP:
+		return fmt.Sprintf("%s %d->%d", f.Protocol, f.SrcPort, f.DstPort)
+	case IPProtoICMP, IPProtoICMPv6:
+		return fmt.Sprintf("%s type=%s", f.Protocol, f.ICMPType)
+	}
+	return fmt.Sprintf("etherType=%s protocol=%s", f.etherType(), f.Protocol)
+}
+
+func (f Flow) etherType() string {
+	if f.EtherType == "" {
+		return EtherTypeIPv4
+	}
+	return f.EtherType
+}
+
+// vrf returns the VRF given by dn. A VRF missing from the policy is assumed enforced, without vzAny contracts.
+func (p FlowPolicy) vrf(dn string) FlowVrf {
+	if v, found := p.Vrfs[dn]; found {
+		return v
+	}
+	return FlowVrf{Dn: dn, Enforced: true}
+}
+
+// Analyze evaluates whether the flow from p.Src to p.Dst is permitted by the policy, without querying APIC.
+// Checks are performed in this order: intra-EPG traffic, VRF enforcement, taboo contracts, preferred group, contracts.
+// A contract applies when the source consumes it and the destination provides it (FlowDirectionConsumerToProvider),
+// or the source provides it and the destination consumes it (FlowDirectionProviderToConsumer).
+// Consumed contract interfaces apply as the contracts they export.
+// Contracts provided or consumed by vzAny apply to every EPG in the VRF.
+// Taboo contracts deny flows into the protected EPG, and flows with reversed ports out of it.
+// TCP session rules and stateful entries are matched against Flow.Established; fin and rst rules are not evaluated.
+// Service graphs are not evaluated.
+func (p FlowPolicy) Analyze(f Flow) FlowVerdict {
+
+	src, dst := p.Src, p.Dst
+
+	if src.Tenant == dst.Tenant && src.ApplicationProfile == dst.ApplicationProfile && src.Name == dst.Name {
+		if src.IntraEPGIsolation {
+			return FlowVerdict{Reason: fmt.Sprintf("intra-EPG traffic denied by isolation: epg=%s", src.Name)}
+		}
+		return FlowVerdict{Allowed: true, Reason: fmt.Sprintf("intra-EPG traffic permitted: epg=%s", src.Name)}
+	}
+
+	srcVrf, dstVrf := p.vrf(src.Vrf), p.vrf(dst.Vrf)
+
+	if !srcVrf.Enforced && !dstVrf.Enforced {
+		return FlowVerdict{Allowed: true, Reason: fmt.Sprintf("policy control unenforced: vrf=%s", strings.Join(sortedNames([]string{src.Vrf, dst.Vrf}), ","))}
+	}
+
+	if v, found := p.tabooMatch(f, dst.Taboos, false); found {
+		return v
+	}
+
+	if v, found := p.tabooMatch(f, src.Taboos, true); found {
+		return v
+	}
+
+	if src.Vrf == dst.Vrf && srcVrf.PreferredGroup && src.PreferredGroupMember && dst.PreferredGroupMember {
+		return FlowVerdict{Allowed: true, Reason: fmt.Sprintf("both EPGs are preferred group members: vrf=%s", src.Vrf)}
+	}
+
+	var notes []string // session state of permit entries rejecting the flow
+
+	if v, found := p.contractMatch(f, FlowDirectionConsumerToProvider,
+		sortedNames(src.Consumed, p.interfaceContracts(src.ConsumedInterfaces), srcVrf.AnyConsumed),
+		sortedNames(dst.Provided, dstVrf.AnyProvided), &notes); found {
+		return v
+	}
+
+	if v, found := p.contractMatch(f, FlowDirectionProviderToConsumer,
+		sortedNames(src.Provided, srcVrf.AnyProvided),
+		sortedNames(dst.Consumed, p.interfaceContracts(dst.ConsumedInterfaces), dstVrf.AnyConsumed), &notes); found {
+		return v
+	}
+
+	reason := fmt.Sprintf("no contract permits flow %s from epg=%s to epg=%s (implicit deny)", f, src.Name, dst.Name)
+	if len(notes) > 0 {
+		reason += "; session state: " + strings.Join(notes, "; ")
+	}
+	if len(p.Unresolved) > 0 {
+		reason += "; unresolved relations not evaluated: " + strings.Join(p.Unresolved, " ")
+	}
+
+	return FlowVerdict{Reason: reason}
+}
+
+// interfaceContracts resolves contract interfaces to the contracts they export.
+func (p FlowPolicy) interfaceContracts(ifaces []string) []string {
+	var contracts []string
+	for _, dn := range ifaces {
+		if contract := p.Interfaces[dn]; contract != "" {
+			contracts = append(contracts, contract)
+		}
+	}
+	return contracts
+}
+
+// tabooMatch searches taboo contracts for a deny entry matching the flow.
+// reverse swaps the source and destination ports of the entries.
+func (p FlowPolicy) tabooMatch(f Flow, taboos []string, reverse bool) (FlowVerdict, bool) {
+	for _, dn := range sortedNames(taboos) {
+		for _, s := range p.Taboos[dn].Subjects {
+			for _, filter := range s.Filters {
+				for _, e := range p.Filters[filter] {
+					match, note := filterEntryMatch(e, f, reverse, false)
+					if !match {
+						continue // session state notes of unmatched deny entries do not explain the verdict
+					}
+					reason := fmt.Sprintf("denied by taboo=%s subject=%s filter=%s entry=%s", dn, s.Name, filter, e.Name)
+					if note != "" {
+						reason += "; " + note
+					}
+					return FlowVerdict{
+						Reason:   reason,
+						Contract: dn,
+						Subject:  s.Name,
+						Filter:   filter,
+						Entry:    e.Name,
+					}, true
+				}
+			}
+		}
+	}
+	return FlowVerdict{}, false
+}
+
+// contractMatch searches the contracts shared between the source and destination for an entry matching the flow.
+// Entries rejected only by TCP session state are reported in notes.
+func (p FlowPolicy) contractMatch(f Flow, dir string, srcContracts, dstContracts []string, notes *[]string) (FlowVerdict, bool) {
+
+	dstSet := map[string]bool{}
+	for _, dn := range dstContracts {
+		dstSet[dn] = true
+	}
+
+	for _, dn := range srcContracts {
+		if !dstSet[dn] {
+			continue
+		}
+		contract, found := p.Contracts[dn]
+		if !found || !p.scopeApplies(contract.Scope) {
+			continue
+		}
+		for _, s := range contract.Subjects {
+			filters, reverse := subjectFilters(s, dir)
+			for _, filter := range filters {
+				for _, e := range p.Filters[filter] {
+					match, note := filterEntryMatch(e, f, reverse, dir == FlowDirectionProviderToConsumer)
+					if !match {
+						if note != "" {
+							*notes = append(*notes, note)
+						}
+						continue
+					}
+					reason := fmt.Sprintf("permitted by contract=%s subject=%s filter=%s entry=%s (%s)", dn, s.Name, filter, e.Name, dir)
+					if note != "" {
+						reason += "; " + note
+					}
+					return FlowVerdict{
+						Allowed:   true,
+						Reason:    reason,
+						Contract:  dn,
+						Subject:   s.Name,
+						Filter:    filter,
+						Entry:     e.Name,
+						Direction: dir,
+					}, true
+				}
+			}
+		}
+	}
+
+	return FlowVerdict{}, false
+}
+
+// scopeApplies reports whether a contract with the given scope applies between the source and destination EPGs.
+func (p FlowPolicy) scopeApplies(scope string) bool {
+	src, dst := p.Src, p.Dst
+	switch scope {
+	case ContractScopeGlobal:
+		return true
+	case ContractScopeTenant:
+		return src.Tenant == dst.Tenant
+	case ContractScopeApplicationProfile:
+		return src.Tenant == dst.Tenant && src.ApplicationProfile == dst.ApplicationProfile
+	}
+	// ContractScopeContext is the default.
+	return src.Vrf == dst.Vrf
+}
+
+// subjectFilters returns the filters a subject applies to a flow direction, and whether their ports are reversed.
+func subjectFilters(s FlowSubject, dir string) ([]string, bool) {
+	if dir == FlowDirectionConsumerToProvider {
+		if s.ApplyBothDirections {
+			return s.Filters, false
+		}
+		return s.InputFilters, false
+	}
+	if s.ApplyBothDirections {
+		return s.Filters, s.ReverseFilterPorts
+	}
+	return s.OutputFilters, false
+}
+
+// filterEntryMatch reports whether a filter entry matches the flow.
+// reverse swaps the source and destination ports of the entry.
+// returnTraffic marks provider-to-consumer traffic, which stateful entries permit only for established sessions.
+// The note explains how TCP session state affected the result, if it did.
+func filterEntryMatch(e FilterEntry, f Flow, reverse, returnTraffic bool) (bool, string) {
+
+	if e.ApplyToFrag != nil && *e.ApplyToFrag {
+		return false, "" // flows are never fragments
+	}
+
+	if !etherTypeMatch(e.EtherType, f.etherType()) {
+		return false, ""
+	}
+
+	if !unspecified(e.Protocol) && e.Protocol != f.Protocol {
+		return false, ""
+	}
+
+	switch f.Protocol {
+	case IPProtoTCP:
+		if !portsMatch(e, f, reverse) {
+			return false, ""
+		}
+		return tcpSessionMatch(e, f, returnTraffic)
+	case IPProtoUDP:
+		return portsMatch(e, f, reverse), ""
+	case IPProtoICMP:
+		return unspecified(e.ICMPv4Type) || e.ICMPv4Type == f.ICMPType, ""
+	case IPProtoICMPv6:
+		return unspecified(e.ICMPv6Type) || e.ICMPv6Type == f.ICMPType, ""
+	}
+
+	return true, ""
+}
+
+func portsMatch(e FilterEntry, f Flow, reverse bool) bool {
+	srcFrom, srcTo, dstFrom, dstTo := e.SrcPortFrom, e.SrcPortTo, e.DstPortFrom, e.DstPortTo
+	if reverse {
+		srcFrom, srcTo, dstFrom, dstTo = dstFrom, dstTo, srcFrom, srcTo
+	}
+	return portRangeMatch(srcFrom, srcTo, f.SrcPort) && portRangeMatch(dstFrom, dstTo, f.DstPort)
+}
+
+// tcpSessionMatch matches the TCP rules and stateful setting of an entry against the session state of the flow.
+func tcpSessionMatch(e FilterEntry, f Flow, returnTraffic bool) (bool, string) {
+
+	for _, rule := range splitFlags(strings.Join(e.TCPRules, ",")) {
+		switch rule {
+		case TCPRuleEstablished, TCPRuleAck:
+			if !f.Established {
+				return false, fmt.Sprintf("entry=%s tcpRules=%s requires an established session", e.Name, rule)
+			}
+		case TCPRuleSyn:
+			if f.Established {
+				return false, fmt.Sprintf("entry=%s tcpRules=%s requires a new connection", e.Name, rule)
+			}
+		default:
+			return false, fmt.Sprintf("entry=%s tcpRules=%s not evaluated", e.Name, rule)
+		}
+	}
+
+	if len(e.TCPRules) > 0 {
+		return true, fmt.Sprintf("entry=%s tcpRules=%s matched session state", e.Name, strings.Join(e.TCPRules, ","))
+	}
+
+	if returnTraffic && e.Stateful != nil && *e.Stateful {
+		if !f.Established {
+			return false, fmt.Sprintf("stateful entry=%s permits return traffic only for established sessions", e.Name)
+		}
+		return true, fmt.Sprintf("stateful entry=%s matched established session", e.Name)
+	}
+
+	return true, ""
+}
+
+func unspecified(value string) bool {
+	return value == "" || value == "unspecified"
+}
+
+func etherTypeMatch(entryType, flowType string) bool {
+	if unspecified(entryType) || entryType == flowType {
+		return true
+	}
+	return entryType == EtherTypeIP && (flowType == EtherTypeIPv4 || flowType == EtherTypeIPv6)
+}
+
+// portRangeMatch reports whether port is within the range. An unspecified range matches any port.
+func portRangeMatch(from, to string, port int) bool {
+	fromN, errFrom := FilterPortNumber(from)
+	if errFrom != nil {
+		return false
+	}
+	toN, errTo := FilterPortNumber(to)
+	if errTo != nil {
+		return false
+	}
+	if fromN == 0 && toN == 0 {
+		return true
+	}
+	if toN == 0 {
+		toN = fromN
+	}
+	return port >= fromN && port <= toN
+}
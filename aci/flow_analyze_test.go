@@ -0,0 +1,221 @@
+package aci
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func loadFlowPolicy(t *testing.T) FlowPolicy {
+	buf, errRead := ioutil.ReadFile("testdata/flow_policy.json")
+	if errRead != nil {
+		t.Fatalf("read fixture: %v", errRead)
+	}
+	var p FlowPolicy
+	if errUnmarshal := json.Unmarshal(buf, &p); errUnmarshal != nil {
+		t.Fatalf("unmarshal fixture: %v", errUnmarshal)
+	}
+	return p
+}
+
+func reversePolicy(p FlowPolicy) FlowPolicy {
+	p.Src, p.Dst = p.Dst, p.Src
+	return p
+}
+
+const (
+	flowWebToDb = "uni/tn-t1/brc-web-to-db"
+	flowBackup  = "uni/tn-t1/brc-backup"
+	flowICMP    = "uni/tn-common/brc-icmp"
+	flowRemote  = "uni/tn-common/brc-remote"
+	flowTaboo   = "uni/tn-t1/taboo-no-telnet"
+	flowVrf     = "uni/tn-t1/ctx-vrf1"
+)
+
+func TestFlowAnalyze(t *testing.T) {
+	p := loadFlowPolicy(t)
+	r := reversePolicy(p)
+
+	// web consumes web-to-db, db provides it: both directions, reverse ports
+	flowAnalyzeTest(t, p, Flow{Protocol: IPProtoTCP, SrcPort: 40000, DstPort: 8443}, true, flowWebToDb, "alt-https", FlowDirectionConsumerToProvider)
+	flowAnalyzeTest(t, p, Flow{EtherType: EtherTypeIPv6, Protocol: IPProtoTCP, SrcPort: 40000, DstPort: 443}, true, flowWebToDb, "https", FlowDirectionConsumerToProvider)
+	flowAnalyzeTest(t, r, Flow{Protocol: IPProtoTCP, SrcPort: 8443, DstPort: 40000, Established: true}, true, flowWebToDb, "alt-https", FlowDirectionProviderToConsumer)
+	flowAnalyzeTest(t, r, Flow{Protocol: IPProtoTCP, SrcPort: 40000, DstPort: 8443}, false, "", "", "")
+	flowAnalyzeTest(t, p, Flow{Protocol: IPProtoTCP, SrcPort: 40000, DstPort: 2222}, false, "", "", "")
+	flowAnalyzeTest(t, p, Flow{Protocol: IPProtoUDP, SrcPort: 40000, DstPort: 8443}, false, "", "", "")
+
+	// db consumes backup, web provides it: separate input/output filters
+	flowAnalyzeTest(t, r, Flow{Protocol: IPProtoTCP, SrcPort: 50000, DstPort: 873}, true, flowBackup, "rsync", FlowDirectionConsumerToProvider)
+	flowAnalyzeTest(t, p, Flow{Protocol: IPProtoTCP, SrcPort: 873, DstPort: 50000, Established: true}, true, flowBackup, "rsync", FlowDirectionProviderToConsumer)
+	flowAnalyzeTest(t, p, Flow{Protocol: IPProtoTCP, SrcPort: 50000, DstPort: 873}, false, "", "", "")
+	flowAnalyzeTest(t, r, Flow{EtherType: EtherTypeIPv6, Protocol: IPProtoTCP, SrcPort: 50000, DstPort: 873}, false, "", "", "")
+
+	// vzAny provides and consumes icmp from tenant common
+	flowAnalyzeTest(t, p, Flow{Protocol: IPProtoICMP, ICMPType: ICMPv4TypeEchoRequest}, true, flowICMP, "echo", FlowDirectionConsumerToProvider)
+	flowAnalyzeTest(t, r, Flow{Protocol: IPProtoICMP, ICMPType: ICMPv4TypeEchoReply}, true, flowICMP, "echo-reply", FlowDirectionConsumerToProvider)
+	flowAnalyzeTest(t, p, Flow{Protocol: IPProtoICMP, ICMPType: ICMPv4TypeUnreachable}, false, "", "", "")
+}
+
+func TestFlowAnalyzeSession(t *testing.T) {
+	p := loadFlowPolicy(t)
+	r := reversePolicy(p)
+
+	// rsync-reply has tcpRules=est: a new connection from the provider is denied
+	v := flowAnalyzeTest(t, p, Flow{Protocol: IPProtoTCP, SrcPort: 873, DstPort: 50000}, false, "", "", "")
+	flowReasonTest(t, v, "tcpRules=est requires an established session")
+
+	// alt-https is stateful: return traffic requires an established session
+	v = flowAnalyzeTest(t, r, Flow{Protocol: IPProtoTCP, SrcPort: 8443, DstPort: 40000}, false, "", "", "")
+	flowReasonTest(t, v, "stateful entry=alt-https")
+	v = flowAnalyzeTest(t, r, Flow{Protocol: IPProtoTCP, SrcPort: 8443, DstPort: 40000, Established: true}, true, flowWebToDb, "alt-https", FlowDirectionProviderToConsumer)
+	flowReasonTest(t, v, "matched established session")
+
+	// syslog has tcpRules=syn: only new connections
+	v = flowAnalyzeTest(t, p, Flow{Protocol: IPProtoTCP, SrcPort: 40000, DstPort: 6514}, true, flowRemote, "syslog", FlowDirectionConsumerToProvider)
+	flowReasonTest(t, v, "tcpRules=syn")
+	v = flowAnalyzeTest(t, p, Flow{Protocol: IPProtoTCP, SrcPort: 40000, DstPort: 6514, Established: true}, false, "", "", "")
+	flowReasonTest(t, v, "tcpRules=syn requires a new connection")
+
+	// fin and rst rules are never matched
+	c := p.Filters["uni/tn-common/flt-remote"]
+	c[2].TCPRules = []string{TCPRuleFin}
+	v = flowAnalyzeTest(t, p, Flow{Protocol: IPProtoTCP, SrcPort: 40000, DstPort: 6514}, false, "", "", "")
+	flowReasonTest(t, v, "tcpRules=fin not evaluated")
+}
+
+func TestFlowAnalyzeInterfaceTaboo(t *testing.T) {
+	p := loadFlowPolicy(t)
+
+	// web consumes contract interface exporting remote from tenant common, db provides remote
+	flowAnalyzeTest(t, p, Flow{Protocol: IPProtoTCP, SrcPort: 40000, DstPort: 22}, true, flowRemote, "ssh", FlowDirectionConsumerToProvider)
+
+	// db is protected by taboo no-telnet, which overrides the remote contract
+	v := flowAnalyzeTest(t, p, Flow{Protocol: IPProtoTCP, SrcPort: 40000, DstPort: 23}, false, flowTaboo, "telnet", "")
+	flowReasonTest(t, v, "denied by taboo="+flowTaboo)
+
+	// taboo protecting the source denies its replies
+	r := reversePolicy(p)
+	r.Dst.Taboos = nil
+	r.Src.Taboos = []string{flowTaboo}
+	flowAnalyzeTest(t, r, Flow{Protocol: IPProtoTCP, SrcPort: 23, DstPort: 40000, Established: true}, false, flowTaboo, "telnet", "")
+
+	// taboo entry not matching on session state is not blamed for the verdict
+	taboo := p.Filters["uni/tn-t1/flt-telnet"]
+	taboo[0].TCPRules = []string{TCPRuleEstablished}
+	v = flowAnalyzeTest(t, p, Flow{Protocol: IPProtoTCP, SrcPort: 40000, DstPort: 23}, true, flowRemote, "telnet", FlowDirectionConsumerToProvider)
+	flowNoReasonTest(t, v, "tcpRules=est")
+	v = flowAnalyzeTest(t, p, Flow{Protocol: IPProtoTCP, SrcPort: 40000, DstPort: 2323}, false, "", "", "")
+	flowNoReasonTest(t, v, "tcpRules=est")
+	taboo[0].DstPortFrom, taboo[0].DstPortTo = "2323", "2323"
+	v = flowAnalyzeTest(t, p, Flow{Protocol: IPProtoTCP, SrcPort: 40000, DstPort: 2323}, false, "", "", "")
+	flowNoReasonTest(t, v, "session state")
+
+	// unresolved contract interface
+	p.Interfaces["uni/tn-t1/cif-remote"] = ""
+	p.Unresolved = []string{"uni/tn-t1/cif-remote"}
+	v = flowAnalyzeTest(t, p, Flow{Protocol: IPProtoTCP, SrcPort: 40000, DstPort: 22}, false, "", "", "")
+	flowReasonTest(t, v, "unresolved relations not evaluated: uni/tn-t1/cif-remote")
+}
+
+func TestFlowAnalyzeScope(t *testing.T) {
+	p := loadFlowPolicy(t)
+	f := Flow{Protocol: IPProtoTCP, SrcPort: 40000, DstPort: 8443}
+
+	// web-to-db has application-profile scope
+	p.Dst.ApplicationProfile = "app2"
+	flowAnalyzeTest(t, p, f, false, "", "", "")
+
+	c := p.Contracts[flowWebToDb]
+	c.Scope = ContractScopeTenant
+	p.Contracts[flowWebToDb] = c
+	flowAnalyzeTest(t, p, f, true, flowWebToDb, "alt-https", FlowDirectionConsumerToProvider)
+
+	// vzAny does not apply to an EPG in another VRF
+	p.Dst.Vrf = "uni/tn-t1/ctx-vrf2"
+	flowAnalyzeTest(t, p, Flow{Protocol: IPProtoICMP, ICMPType: ICMPv4TypeEchoRequest}, false, "", "", "")
+}
+
+func TestFlowAnalyzeShortcuts(t *testing.T) {
+	f := Flow{Protocol: IPProtoTCP, SrcPort: 40000, DstPort: 2222}
+
+	p := loadFlowPolicy(t)
+	v := p.Vrfs[flowVrf]
+	v.PreferredGroup = true
+	p.Vrfs[flowVrf] = v
+	flowAnalyzeTest(t, p, f, true, "", "", "")
+	p.Dst.PreferredGroupMember = false
+	flowAnalyzeTest(t, p, f, false, "", "", "")
+
+	p = loadFlowPolicy(t)
+	v = p.Vrfs[flowVrf]
+	v.Enforced = false
+	p.Vrfs[flowVrf] = v
+	flowAnalyzeTest(t, p, f, true, "", "", "")
+
+	// VRF missing from the policy is assumed enforced
+	delete(p.Vrfs, flowVrf)
+	flowAnalyzeTest(t, p, f, false, "", "", "")
+
+	p = loadFlowPolicy(t)
+	p.Dst = p.Src
+	flowAnalyzeTest(t, p, f, true, "", "", "")
+	p.Src.IntraEPGIsolation = true
+	p.Dst.IntraEPGIsolation = true
+	flowAnalyzeTest(t, p, f, false, "", "", "")
+}
+
+func flowAnalyzeTest(t *testing.T, p FlowPolicy, f Flow, wantAllowed bool, wantContract, wantEntry, wantDir string) FlowVerdict {
+	v := p.Analyze(f)
+	if v.Allowed != wantAllowed || v.Contract != wantContract || v.Entry != wantEntry || v.Direction != wantDir {
+		t.Errorf("src=%s dst=%s flow=%s: want allowed=%v contract=%s entry=%s dir=%s got %+v",
+			p.Src.Name, p.Dst.Name, f, wantAllowed, wantContract, wantEntry, wantDir, v)
+	}
+	if v.Reason == "" {
+		t.Errorf("src=%s dst=%s flow=%s: empty reason", p.Src.Name, p.Dst.Name, f)
+	}
+	return v
+}
+
+func flowNoReasonTest(t *testing.T, v FlowVerdict, unwanted string) {
+	if strings.Contains(v.Reason, unwanted) {
+		t.Errorf("reason=[%s] unexpected [%s]", v.Reason, unwanted)
+	}
+}
+
+func flowReasonTest(t *testing.T, v FlowVerdict, want string) {
+	if !strings.Contains(v.Reason, want) {
+		t.Errorf("reason=[%s] missing [%s]", v.Reason, want)
+	}
+}
+
+func TestPortRangeMatch(t *testing.T) {
+	portRangeMatchTest(t, "", "", 22, true)
+	portRangeMatchTest(t, "unspecified", "unspecified", 22, true)
+	portRangeMatchTest(t, "http", "", 80, true)
+	portRangeMatchTest(t, "1024", "65535", 8443, true)
+	portRangeMatchTest(t, "1024", "65535", 443, false)
+	portRangeMatchTest(t, "bogus", "", 80, false)
+}
+
+func portRangeMatchTest(t *testing.T, from, to string, port int, want bool) {
+	if got := portRangeMatch(from, to, port); got != want {
+		t.Errorf("from=%s to=%s port=%d want=%v got=%v", from, to, port, want, got)
+	}
+}
+
+func TestDnSplit(t *testing.T) {
+	dnSplitTest(t, "uni/tn-common/brc-default", "brc-", "common", "default", false)
+	dnSplitTest(t, "uni/tn-t1/flt-web", "flt-", "t1", "web", false)
+	dnSplitTest(t, "uni/tn-t1/flt-web", "brc-", "", "", true)
+	dnSplitTest(t, "uni/tn-t1/ap-app1/epg-web", "epg-", "", "", true)
+	dnSplitTest(t, "", "ctx-", "", "", true)
+}
+
+func dnSplitTest(t *testing.T, dn, prefix, wantTenant, wantName string, wantError bool) {
+	tenant, name, err := dnSplit(dn, prefix)
+	if (err != nil) != wantError || tenant != wantTenant || name != wantName {
+		t.Errorf("dn=%s prefix=%s: want tenant=%s name=%s error=%v got tenant=%s name=%s error=%v",
+			dn, prefix, wantTenant, wantName, wantError, tenant, name, err)
+	}
+}
@@ -0,0 +1,245 @@
+package aci
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// HealthScore holds the health score for an object.
+type HealthScore struct {
+	Dn     string // DN of the object. Example: "uni/tn-prod"
+	Class  string // Class of the object, when known. Example: "fvTenant"
+	Cur    int    // Current health score: 0..100
+	Prev   int    // Previous health score: 0..100
+	MaxSev string // Highest severity of the faults affecting the object.
+}
+
+// healthFromAttributes builds a health score from healthInst or fabricHealthTotal attributes.
+func healthFromAttributes(class string, attr map[string]interface{}) HealthScore {
+	cur, _ := strconv.Atoi(mapString(attr, "cur"))
+	prev, _ := strconv.Atoi(mapString(attr, "prev"))
+	return HealthScore{
+		Dn:     strings.TrimSuffix(mapString(attr, "dn"), "/health"),
+		Class:  class,
+		Cur:    cur,
+		Prev:   prev,
+		MaxSev: mapString(attr, "maxSev"),
+	}
+}
+
+// healthFromObject finds the health score among the children of an object retrieved with rsp-subtree-include=health.
+func healthFromObject(obj imdataObject) (HealthScore, bool) {
+	list := obj.childrenByClass("healthInst")
+	if len(list) < 1 {
+		return HealthScore{}, false
+	}
+	h := healthFromAttributes(obj.class, list[0].attr)
+	if dn := mapString(obj.attr, "dn"); dn != "" {
+		h.Dn = dn
+	}
+	return h, true
+}
+
+// healthChildren collects the health scores of the children of an object retrieved with rsp-subtree-include=health, lowest first.
+// Children without health score are skipped.
+func healthChildren(obj imdataObject) []HealthScore {
+	var children []HealthScore
+	for _, child := range obj.children {
+		if h, found := healthFromObject(child); found {
+			children = append(children, h)
+		}
+	}
+	healthSort(children)
+	return children
+}
+
+// healthSort sorts health scores lowest first.
+func healthSort(list []HealthScore) {
+	sort.SliceStable(list, func(i, j int) bool { return list[i].Cur < list[j].Cur })
+}
+
+// healthGet retrieves the health score object (healthInst or fabricHealthTotal) at dn.
+func (c *Client) healthGet(me, dn string) (HealthScore, error) {
+
+	api := "/api/node/mo/" + dn + ".json"
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return HealthScore{}, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	objs, errObj := jsonImdataObjects(c, body, me)
+	if errObj != nil {
+		return HealthScore{}, errObj
+	}
+
+	for _, obj := range objs {
+		switch obj.class {
+		case "healthInst", "fabricHealthTotal":
+			return healthFromAttributes("", obj.attr), nil
+		}
+	}
+
+	return HealthScore{}, fmt.Errorf("%s: health score not found: %s", me, dn)
+}
+
+// HealthFabric retrieves the overall fabric health score.
+func (c *Client) HealthFabric() (HealthScore, error) {
+	return c.healthGet("HealthFabric", "topology/health")
+}
+
+// HealthPod retrieves the health score for a pod. Example: pod="1"
+func (c *Client) HealthPod(pod string) (HealthScore, error) {
+	return c.healthGet("HealthPod", "topology/pod-"+pod+"/health")
+}
+
+// HealthNode retrieves the health score for a node. Example: pod="1" node="101"
+func (c *Client) HealthNode(pod, node string) (HealthScore, error) {
+	return c.healthGet("HealthNode", "topology/pod-"+pod+"/node-"+node+"/sys/health")
+}
+
+// HealthTenant retrieves the health score for a tenant.
+func (c *Client) HealthTenant(tenant string) (HealthScore, error) {
+	return c.healthGet("HealthTenant", "uni/"+rnTenant(tenant)+"/health")
+}
+
+// HealthApplicationProfile retrieves the health score for an application profile.
+func (c *Client) HealthApplicationProfile(tenant, applicationProfile string) (HealthScore, error) {
+	return c.healthGet("HealthApplicationProfile", "uni/"+dnAP(tenant, applicationProfile)+"/health")
+}
+
+// HealthEPG retrieves the health score for an application EPG.
+func (c *Client) HealthEPG(tenant, applicationProfile, epg string) (HealthScore, error) {
+	return c.healthGet("HealthEPG", "uni/"+dnAEPG(tenant, applicationProfile, epg)+"/health")
+}
+
+// HealthPodList retrieves the health scores for all pods, lowest first.
+func (c *Client) HealthPodList() ([]HealthScore, error) {
+
+	me := "HealthPodList"
+
+	key := "fabricHealthTotal"
+
+	api := "/api/node/class/" + key + ".json"
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	attrs, errAttr := jsonImdataAttributes(c, body, key, me)
+	if errAttr != nil {
+		return nil, fmt.Errorf("%s: %v", me, errAttr)
+	}
+
+	var list []HealthScore
+	for _, attr := range attrs {
+		h := healthFromAttributes("", attr)
+		if !strings.HasPrefix(extractTail(h.Dn), "pod-") {
+			continue // skip fabric total
+		}
+		list = append(list, h)
+	}
+
+	healthSort(list)
+
+	return list, nil
+}
+
+// HealthClassList retrieves the health scores for all objects of a class, lowest first.
+// Example classes: "fvTenant", "fvAp", "fvAEPg", "fabricNode"
+func (c *Client) HealthClassList(class string) ([]HealthScore, error) {
+
+	me := "HealthClassList"
+
+	api := "/api/node/class/" + class + ".json?rsp-subtree-include=health"
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	objs, errObj := jsonImdataObjects(c, body, me)
+	if errObj != nil {
+		return nil, errObj
+	}
+
+	var list []HealthScore
+	for _, obj := range objs {
+		if h, found := healthFromObject(obj); found {
+			list = append(list, h)
+		}
+	}
+
+	healthSort(list)
+
+	return list, nil
+}
+
+// HealthTenantList retrieves the health scores for all tenants, lowest first.
+func (c *Client) HealthTenantList() ([]HealthScore, error) {
+	return c.HealthClassList("fvTenant")
+}
+
+// HealthNodeList retrieves the health scores for all fabric nodes, lowest first.
+func (c *Client) HealthNodeList() ([]HealthScore, error) {
+	return c.HealthClassList("fabricNode")
+}
+
+// HealthWithChildren retrieves the health score for an object, given by its DN, together with the health scores of its children, lowest first.
+// Example: dn="uni/tn-prod" reports the tenant health along with the health of its application profiles, bridge domains, VRFs, etc.
+func (c *Client) HealthWithChildren(dn string) (HealthScore, []HealthScore, error) {
+
+	me := "HealthWithChildren"
+
+	api := "/api/node/mo/" + dn + ".json?rsp-subtree=children&rsp-subtree-include=health"
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return HealthScore{}, nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	objs, errObj := jsonImdataObjects(c, body, me)
+	if errObj != nil {
+		return HealthScore{}, nil, errObj
+	}
+
+	if len(objs) < 1 {
+		return HealthScore{}, nil, fmt.Errorf("%s: object not found: %s", me, dn)
+	}
+
+	parent := objs[0]
+
+	health, found := healthFromObject(parent)
+	if !found {
+		return HealthScore{}, nil, fmt.Errorf("%s: health score not found: %s", me, dn)
+	}
+
+	return health, healthChildren(parent), nil
+}
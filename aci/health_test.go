@@ -0,0 +1,58 @@
+package aci
+
+import (
+	"testing"
+)
+
+type testDebug struct {
+	t *testing.T
+}
+
+func (d testDebug) debugf(fmt string, v ...interface{}) {
+	d.t.Logf(fmt, v...)
+}
+
+func TestHealthWithChildren(t *testing.T) {
+
+	body := `{"totalCount":"1","imdata":[{"fvTenant":{"attributes":{"dn":"uni/tn-prod","name":"prod"},"children":[
+{"healthInst":{"attributes":{"cur":"90","prev":"95","maxSev":"major","rn":"health"}}},
+{"fvAp":{"attributes":{"name":"app","rn":"ap-app"},"children":[{"healthInst":{"attributes":{"cur":"100","prev":"100","maxSev":"cleared","rn":"health"}}}]}},
+{"fvBD":{"attributes":{"name":"web","rn":"BD-web"},"children":[{"healthInst":{"attributes":{"cur":"80","prev":"100","maxSev":"major","rn":"health"}}}]}},
+{"fvRsTenantMonPol":{"attributes":{"rn":"rsTenantMonPol"}}}
+]}}]}`
+
+	objs, errObj := jsonImdataObjects(testDebug{t}, []byte(body), "TestHealthWithChildren")
+	if errObj != nil {
+		t.Fatalf("parse error: %v", errObj)
+	}
+	if len(objs) != 1 {
+		t.Fatalf("want 1 object, got %d", len(objs))
+	}
+
+	h, found := healthFromObject(objs[0])
+	if !found {
+		t.Fatalf("tenant health not found")
+	}
+	if h.Dn != "uni/tn-prod" || h.Cur != 90 || h.Prev != 95 || h.MaxSev != "major" || h.Class != "fvTenant" {
+		t.Errorf("unexpected tenant health: %v", h)
+	}
+
+	children := healthChildren(objs[0])
+
+	if len(children) != 2 {
+		t.Fatalf("want 2 children, got %d: %v", len(children), children)
+	}
+	if children[0].Dn != "uni/tn-prod/BD-web" || children[0].Cur != 80 {
+		t.Errorf("unexpected lowest child: %v", children[0])
+	}
+	if children[1].Dn != "uni/tn-prod/ap-app" || children[1].Cur != 100 {
+		t.Errorf("unexpected highest child: %v", children[1])
+	}
+}
+
+func TestHealthImdataError(t *testing.T) {
+	body := `{"totalCount":"1","imdata":[{"error":{"attributes":{"code":"403","text":"denied"}}}]}`
+	if _, err := jsonImdataObjects(testDebug{t}, []byte(body), "TestHealthImdataError"); err == nil {
+		t.Errorf("expected error")
+	}
+}
@@ -102,3 +102,81 @@ func extractKeyAttributes(c hasDebugf, list []interface{}, key, label string) []
 type hasDebugf interface {
 	debugf(fmt string, v ...interface{})
 }
+
+// imdataObject is a managed object found in imdata, along with its children.
+type imdataObject struct {
+	class    string
+	attr     map[string]interface{}
+	children []imdataObject
+}
+
+// childrenByClass retrieves the children of a given class.
+func (o imdataObject) childrenByClass(class string) []imdataObject {
+	var list []imdataObject
+	for _, child := range o.children {
+		if child.class == class {
+			list = append(list, child)
+		}
+	}
+	return list
+}
+
+// jsonImdataObjects retrieves the objects from imdata, including children requested with rsp-subtree options.
+func jsonImdataObjects(c hasDebugf, body []byte, label string) ([]imdataObject, error) {
+
+	var reply interface{}
+	errJSON := json.Unmarshal(body, &reply)
+	if errJSON != nil {
+		return nil, errJSON
+	}
+
+	if errReply := imdataExtractError(reply); errReply != nil {
+		return nil, fmt.Errorf("%s: %v", label, errReply)
+	}
+
+	imdata, errImdata := mapGet(reply, "imdata")
+	if errImdata != nil {
+		return nil, fmt.Errorf("%s: missing imdata: %v", label, errImdata)
+	}
+
+	list, isList := imdata.([]interface{})
+	if !isList {
+		return nil, fmt.Errorf("%s: imdata does not hold a list", label)
+	}
+
+	return extractObjects(c, list, "", label), nil
+}
+
+// extractObjects parses a list of objects.
+// Children usually carry only their rn, so their dn is built from the parent dn.
+func extractObjects(c hasDebugf, list []interface{}, parentDn, label string) []imdataObject {
+
+	result := make([]imdataObject, 0, len(list))
+
+	for _, i := range list {
+		m, isMap := i.(map[string]interface{})
+		if !isMap {
+			c.debugf("%s: not a map: %v", label, i)
+			continue
+		}
+		for class, item := range m {
+			attr, isAttrMap := mapSimple(item, "attributes").(map[string]interface{})
+			if !isAttrMap {
+				c.debugf("%s: missing attributes: %v", label, item)
+				continue
+			}
+			if mapString(attr, "dn") == "" && parentDn != "" {
+				if rn := mapString(attr, "rn"); rn != "" {
+					attr["dn"] = parentDn + "/" + rn
+				}
+			}
+			obj := imdataObject{class: class, attr: attr}
+			if children, isList := mapSimple(item, "children").([]interface{}); isList {
+				obj.children = extractObjects(c, children, mapString(attr, "dn"), label)
+			}
+			result = append(result, obj)
+		}
+	}
+
+	return result
+}
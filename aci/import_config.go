@@ -0,0 +1,201 @@
+package aci
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+)
+
+// Import types: how the imported configuration is combined with the existing configuration.
+const (
+	ImportTypeMerge   = "merge"   // Import type. Imported configuration is merged with existing configuration.
+	ImportTypeReplace = "replace" // Import type. Imported configuration replaces existing configuration.
+)
+
+// Import modes: how errors are handled while importing the configuration.
+const (
+	ImportModeBestEffort = "best-effort" // Import mode. Objects with errors are skipped.
+	ImportModeAtomic     = "atomic"      // Import mode. Shards with errors are entirely skipped.
+)
+
+func rnImportConfig(config string) string {
+	return "configimp-" + config
+}
+
+// ImportConfigurationAdd creates a new import configuration.
+// fileName is the name of the backup file in the remote location. Example: "ce2_DailyAutoBackup-2017-05-12T10-04-33.tar.gz"
+// importType: ImportTypeMerge, ImportTypeReplace, "" (empty means default)
+// importMode: ImportModeBestEffort, ImportModeAtomic, "" (empty means default)
+func (c *Client) ImportConfigurationAdd(config, fileName, remoteLocation, importType, importMode, descr string) error {
+
+	me := "ImportConfigurationAdd"
+
+	rn := rnImportConfig(config)
+
+	api := "/api/node/mo/uni/fabric/" + rn + ".json"
+
+	url := c.getURL(api)
+
+	var attrType string
+	if importType != "" {
+		attrType = fmt.Sprintf(`,"importType":"%s"`, importType)
+	}
+
+	var attrMode string
+	if importMode != "" {
+		attrMode = fmt.Sprintf(`,"importMode":"%s"`, importMode)
+	}
+
+	j := fmt.Sprintf(`{"configImportP":{"attributes":{"dn":"uni/fabric/%s","name":"%s","descr":"%s","fileName":"%s"%s%s,"rn":"%s","status":"created"},"children":[{"configRsRemotePath":{"attributes":{"tnFileRemotePathName":"%s","status":"created,modified"}}}]}}`,
+		rn, config, descr, fileName, attrType, attrMode, rn, remoteLocation)
+
+	c.debugf("%s: url=%s json=%s", me, url, j)
+
+	body, errPost := c.post(url, contentTypeJSON, bytes.NewBufferString(j))
+	if errPost != nil {
+		return fmt.Errorf("%s: %v", me, errPost)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return parseJSONError(body)
+}
+
+// ImportConfigurationDel deletes an existing import configuration.
+func (c *Client) ImportConfigurationDel(config string) error {
+
+	me := "ImportConfigurationDel"
+
+	rn := rnImportConfig(config)
+
+	api := "/api/node/mo/uni/fabric.json"
+
+	url := c.getURL(api)
+
+	j := fmt.Sprintf(`{"fabricInst":{"attributes":{"dn":"uni/fabric","status":"modified"},"children":[{"configImportP":{"attributes":{"dn":"uni/fabric/%s","status":"deleted"}}}]}}`,
+		rn)
+
+	c.debugf("%s: url=%s json=%s", me, url, j)
+
+	body, errPost := c.post(url, contentTypeJSON, bytes.NewBufferString(j))
+	if errPost != nil {
+		return fmt.Errorf("%s: %v", me, errPost)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return parseJSONError(body)
+}
+
+// ImportConfigurationList retrieves the list of import configurations.
+func (c *Client) ImportConfigurationList() ([]map[string]interface{}, error) {
+
+	me := "ImportConfigurationList"
+
+	key := "configImportP"
+
+	api := "/api/node/class/" + key + ".json"
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return jsonImdataAttributes(c, body, key, me)
+}
+
+// ImportConfigurationRemoteLocationGet retrieves the remote location attached to an import configuration.
+func (c *Client) ImportConfigurationRemoteLocationGet(config string) (map[string]interface{}, error) {
+
+	me := "ImportConfigurationRemoteLocationGet"
+
+	rn := rnImportConfig(config)
+
+	key := "configRsRemotePath"
+
+	api := "/api/node/mo/uni/fabric/" + rn + ".json?query-target=children&target-subtree-class=" + key
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	list, errImdata := jsonImdataAttributes(c, body, key, me)
+	if errImdata != nil {
+		return nil, fmt.Errorf("%s: %v", me, errImdata)
+	}
+
+	if len(list) < 1 {
+		return nil, fmt.Errorf("%s: empty list", me)
+	}
+
+	return list[0], nil
+}
+
+// ImportConfigurationRun executes the import configuration now.
+// Use ImportConfigurationRunWait() to wait for the import job to finish.
+func (c *Client) ImportConfigurationRun(config string) error {
+
+	// A policy can be triggered at any time by setting the adminSt to triggered.
+
+	me := "ImportConfigurationRun"
+
+	rn := rnImportConfig(config)
+
+	api := "/api/node/mo/uni/fabric/" + rn + ".json"
+
+	url := c.getURL(api)
+
+	j := fmt.Sprintf(`{"configImportP":{"attributes":{"dn":"uni/fabric/%s","adminSt":"triggered"}}}`,
+		rn)
+
+	c.debugf("%s: url=%s json=%s", me, url, j)
+
+	body, errPost := c.post(url, contentTypeJSON, bytes.NewBufferString(j))
+	if errPost != nil {
+		return fmt.Errorf("%s: %v", me, errPost)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return parseJSONError(body)
+}
+
+// ImportConfigurationJobList retrieves the list of jobs executed for an import configuration.
+func (c *Client) ImportConfigurationJobList(config string) ([]ConfigJob, error) {
+	return c.configJobList("ImportConfigurationJobList", "fabric/"+rnImportConfig(config))
+}
+
+// ImportConfigurationRunWait executes the import configuration now and waits for the resulting job to finish.
+// The finished job is returned. Use ConfigJob.Success() to check the job result and ConfigJob.Details for the failure reason.
+func (c *Client) ImportConfigurationRunWait(config string, timeout time.Duration) (ConfigJob, error) {
+
+	me := "ImportConfigurationRunWait"
+
+	dn := "fabric/" + rnImportConfig(config)
+
+	jobs, errList := c.configJobList(me, dn)
+	if errList != nil {
+		return ConfigJob{}, errList
+	}
+
+	known := configJobKnown(jobs)
+
+	if errRun := c.ImportConfigurationRun(config); errRun != nil {
+		return ConfigJob{}, fmt.Errorf("%s: %v", me, errRun)
+	}
+
+	return c.configJobWait(me, dn, known, timeout)
+}
@@ -0,0 +1,254 @@
+package aci
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LACP modes for InterfaceLacpPolicyAdd.
+const (
+	LacpModeOff              = "off"
+	LacpModeActive           = "active"
+	LacpModePassive          = "passive"
+	LacpModeMacPin           = "mac-pin"
+	LacpModeMacPinNicLoad    = "mac-pin-nicload"
+	LacpModeExplicitFailover = "explicit-failover"
+)
+
+// LACP control flags for InterfaceLacpPolicyAdd.
+const (
+	LacpCtrlFastSelectHotStandby = "fast-sel-hot-stdby"
+	LacpCtrlGracefulConvergence  = "graceful-conv"
+	LacpCtrlSuspendIndividual    = "susp-individual"
+	LacpCtrlLoadDefer            = "load-defer"
+	LacpCtrlSymmetricHash        = "symmetric-hash"
+)
+
+// interfacePolicyAdd creates an interface policy under uni/infra.
+// attrs holds extra attributes already formatted as `,"name":"value"` pairs.
+func (c *Client) interfacePolicyAdd(me, class, rn, name, descr, attrs string) error {
+
+	j := fmt.Sprintf(`{"%s":{"attributes":{"dn":"uni/infra/%s","name":"%s","descr":"%s"%s,"rn":"%s","status":"created"}}}`,
+		class, rn, name, descr, attrs, rn)
+
+	return c.moPost(me, "infra/"+rn, j)
+}
+
+// interfacePolicyDel deletes an interface policy under uni/infra.
+func (c *Client) interfacePolicyDel(me, class, rn string) error {
+
+	j := fmt.Sprintf(`{"infraInfra":{"attributes":{"dn":"uni/infra","status":"modified"},"children":[{"%s":{"attributes":{"dn":"uni/infra/%s","status":"deleted"}}}]}}`,
+		class, rn)
+
+	return c.moPost(me, "infra", j)
+}
+
+// interfacePolicyList retrieves the interface policies of a class.
+func (c *Client) interfacePolicyList(me, key string) ([]map[string]interface{}, error) {
+
+	api := "/api/node/class/" + key + ".json"
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return jsonImdataAttributes(c, body, key, me)
+}
+
+// InterfaceLinkLevelPolicyAdd creates a link level policy.
+// speed: "inherit", "100M", "1G", "10G", "25G", "40G", "100G", "" (empty means default)
+// autoNeg: "on", "off", "" (empty means default)
+// fecMode: "inherit", "cl74-fc-fec", "cl91-rs-fec", "disable-fec", "" (empty means default)
+func (c *Client) InterfaceLinkLevelPolicyAdd(policy, speed, autoNeg, fecMode, descr string) error {
+	attrs := optionalAttr("speed", speed) + optionalAttr("autoNeg", autoNeg) + optionalAttr("fecMode", fecMode)
+	return c.interfacePolicyAdd("InterfaceLinkLevelPolicyAdd", "fabricHIfPol", "hintfpol-"+policy, policy, descr, attrs)
+}
+
+// InterfaceLinkLevelPolicyDel deletes a link level policy.
+func (c *Client) InterfaceLinkLevelPolicyDel(policy string) error {
+	return c.interfacePolicyDel("InterfaceLinkLevelPolicyDel", "fabricHIfPol", "hintfpol-"+policy)
+}
+
+// InterfaceLinkLevelPolicyList retrieves the list of link level policies.
+func (c *Client) InterfaceLinkLevelPolicyList() ([]map[string]interface{}, error) {
+	return c.interfacePolicyList("InterfaceLinkLevelPolicyList", "fabricHIfPol")
+}
+
+// InterfaceCdpPolicyAdd creates a CDP interface policy.
+func (c *Client) InterfaceCdpPolicyAdd(policy string, enable bool, descr string) error {
+	attrs := optionalAttr("adminSt", enabledDisabled(enable))
+	return c.interfacePolicyAdd("InterfaceCdpPolicyAdd", "cdpIfPol", "cdpIfP-"+policy, policy, descr, attrs)
+}
+
+// InterfaceCdpPolicyDel deletes a CDP interface policy.
+func (c *Client) InterfaceCdpPolicyDel(policy string) error {
+	return c.interfacePolicyDel("InterfaceCdpPolicyDel", "cdpIfPol", "cdpIfP-"+policy)
+}
+
+// InterfaceCdpPolicyList retrieves the list of CDP interface policies.
+func (c *Client) InterfaceCdpPolicyList() ([]map[string]interface{}, error) {
+	return c.interfacePolicyList("InterfaceCdpPolicyList", "cdpIfPol")
+}
+
+// InterfaceLldpPolicyAdd creates a LLDP interface policy.
+func (c *Client) InterfaceLldpPolicyAdd(policy string, receive, transmit bool, descr string) error {
+	attrs := optionalAttr("adminRxSt", enabledDisabled(receive)) + optionalAttr("adminTxSt", enabledDisabled(transmit))
+	return c.interfacePolicyAdd("InterfaceLldpPolicyAdd", "lldpIfPol", "lldpIfP-"+policy, policy, descr, attrs)
+}
+
+// InterfaceLldpPolicyDel deletes a LLDP interface policy.
+func (c *Client) InterfaceLldpPolicyDel(policy string) error {
+	return c.interfacePolicyDel("InterfaceLldpPolicyDel", "lldpIfPol", "lldpIfP-"+policy)
+}
+
+// InterfaceLldpPolicyList retrieves the list of LLDP interface policies.
+func (c *Client) InterfaceLldpPolicyList() ([]map[string]interface{}, error) {
+	return c.interfacePolicyList("InterfaceLldpPolicyList", "lldpIfPol")
+}
+
+// InterfaceLacpPolicyAdd creates a LACP (port channel) policy.
+// mode: LacpModeOff, LacpModeActive, LacpModePassive, LacpModeMacPin, LacpModeMacPinNicLoad, LacpModeExplicitFailover, "" (empty means default)
+// ctrl: list of LacpCtrl* flags. Empty list means default flags.
+func (c *Client) InterfaceLacpPolicyAdd(policy, mode string, ctrl []string, descr string) error {
+	attrs := optionalAttr("mode", mode) + optionalAttr("ctrl", strings.Join(ctrl, ","))
+	return c.interfacePolicyAdd("InterfaceLacpPolicyAdd", "lacpLagPol", "lacplagp-"+policy, policy, descr, attrs)
+}
+
+// InterfaceLacpPolicyDel deletes a LACP (port channel) policy.
+func (c *Client) InterfaceLacpPolicyDel(policy string) error {
+	return c.interfacePolicyDel("InterfaceLacpPolicyDel", "lacpLagPol", "lacplagp-"+policy)
+}
+
+// InterfaceLacpPolicyList retrieves the list of LACP (port channel) policies.
+func (c *Client) InterfaceLacpPolicyList() ([]map[string]interface{}, error) {
+	return c.interfacePolicyList("InterfaceLacpPolicyList", "lacpLagPol")
+}
+
+// InterfaceMcpPolicyAdd creates a MCP (miscabling protocol) interface policy.
+func (c *Client) InterfaceMcpPolicyAdd(policy string, enable bool, descr string) error {
+	attrs := optionalAttr("adminSt", enabledDisabled(enable))
+	return c.interfacePolicyAdd("InterfaceMcpPolicyAdd", "mcpIfPol", "mcpIfP-"+policy, policy, descr, attrs)
+}
+
+// InterfaceMcpPolicyDel deletes a MCP (miscabling protocol) interface policy.
+func (c *Client) InterfaceMcpPolicyDel(policy string) error {
+	return c.interfacePolicyDel("InterfaceMcpPolicyDel", "mcpIfPol", "mcpIfP-"+policy)
+}
+
+// InterfaceMcpPolicyList retrieves the list of MCP (miscabling protocol) interface policies.
+func (c *Client) InterfaceMcpPolicyList() ([]map[string]interface{}, error) {
+	return c.interfacePolicyList("InterfaceMcpPolicyList", "mcpIfPol")
+}
+
+// InterfaceStormControlPolicyAdd creates a storm control interface policy.
+// rate and burstRate are percentages of the port bandwidth, applied to all traffic types. Example: rate="50.000000" burstRate="60.000000"
+// action: "drop", "shutdown", "" (empty means default)
+func (c *Client) InterfaceStormControlPolicyAdd(policy, rate, burstRate, action, descr string) error {
+	attrs := optionalAttr("rate", rate) + optionalAttr("burstRate", burstRate) + optionalAttr("stormCtrlAction", action)
+	return c.interfacePolicyAdd("InterfaceStormControlPolicyAdd", "stormctrlIfPol", "stormctrlifp-"+policy, policy, descr, attrs)
+}
+
+// InterfaceStormControlPolicyDel deletes a storm control interface policy.
+func (c *Client) InterfaceStormControlPolicyDel(policy string) error {
+	return c.interfacePolicyDel("InterfaceStormControlPolicyDel", "stormctrlIfPol", "stormctrlifp-"+policy)
+}
+
+// InterfaceStormControlPolicyList retrieves the list of storm control interface policies.
+func (c *Client) InterfaceStormControlPolicyList() ([]map[string]interface{}, error) {
+	return c.interfacePolicyList("InterfaceStormControlPolicyList", "stormctrlIfPol")
+}
+
+// InterfacePortSecurityPolicyAdd creates a port security policy.
+// maximum is the maximum number of endpoints learned on the port. Example: "10"
+// timeout is the timeout in seconds for endpoint learning. Example: "60"
+func (c *Client) InterfacePortSecurityPolicyAdd(policy, maximum, timeout, descr string) error {
+	attrs := optionalAttr("maximum", maximum) + optionalAttr("timeout", timeout)
+	return c.interfacePolicyAdd("InterfacePortSecurityPolicyAdd", "l2PortSecurityPol", "portsecurityP-"+policy, policy, descr, attrs)
+}
+
+// InterfacePortSecurityPolicyDel deletes a port security policy.
+func (c *Client) InterfacePortSecurityPolicyDel(policy string) error {
+	return c.interfacePolicyDel("InterfacePortSecurityPolicyDel", "l2PortSecurityPol", "portsecurityP-"+policy)
+}
+
+// InterfacePortSecurityPolicyList retrieves the list of port security policies.
+func (c *Client) InterfacePortSecurityPolicyList() ([]map[string]interface{}, error) {
+	return c.interfacePolicyList("InterfacePortSecurityPolicyList", "l2PortSecurityPol")
+}
+
+// policyGroupRelationSet attaches an interface policy, by name, to a leaf policy group.
+// groupRn: "accportgrp-" + group, "accbundle-" + group
+func (c *Client) policyGroupRelationSet(me, groupRn, class, attr, policy string) error {
+
+	j := fmt.Sprintf(`{"%s":{"attributes":{"%s":"%s","status":"created,modified"}}}`,
+		class, attr, policy)
+
+	return c.moPost(me, "infra/funcprof/"+groupRn, j)
+}
+
+// LeafInterfacePolicyGroupLinkLevelSet attaches a link level policy to the access port policy group.
+func (c *Client) LeafInterfacePolicyGroupLinkLevelSet(group, policy string) error {
+	return c.policyGroupRelationSet("LeafInterfacePolicyGroupLinkLevelSet", rnLeafPortGroup(group), "infraRsHIfPol", "tnFabricHIfPolName", policy)
+}
+
+// LeafInterfacePolicyGroupCdpSet attaches a CDP interface policy to the access port policy group.
+func (c *Client) LeafInterfacePolicyGroupCdpSet(group, policy string) error {
+	return c.policyGroupRelationSet("LeafInterfacePolicyGroupCdpSet", rnLeafPortGroup(group), "infraRsCdpIfPol", "tnCdpIfPolName", policy)
+}
+
+// LeafInterfacePolicyGroupLldpSet attaches a LLDP interface policy to the access port policy group.
+func (c *Client) LeafInterfacePolicyGroupLldpSet(group, policy string) error {
+	return c.policyGroupRelationSet("LeafInterfacePolicyGroupLldpSet", rnLeafPortGroup(group), "infraRsLldpIfPol", "tnLldpIfPolName", policy)
+}
+
+// LeafInterfacePolicyGroupMcpSet attaches a MCP interface policy to the access port policy group.
+func (c *Client) LeafInterfacePolicyGroupMcpSet(group, policy string) error {
+	return c.policyGroupRelationSet("LeafInterfacePolicyGroupMcpSet", rnLeafPortGroup(group), "infraRsMcpIfPol", "tnMcpIfPolName", policy)
+}
+
+// LeafInterfacePolicyGroupStormControlSet attaches a storm control interface policy to the access port policy group.
+func (c *Client) LeafInterfacePolicyGroupStormControlSet(group, policy string) error {
+	return c.policyGroupRelationSet("LeafInterfacePolicyGroupStormControlSet", rnLeafPortGroup(group), "infraRsStormctrlIfPol", "tnStormctrlIfPolName", policy)
+}
+
+// LeafInterfacePolicyGroupPortSecuritySet attaches a port security policy to the access port policy group.
+func (c *Client) LeafInterfacePolicyGroupPortSecuritySet(group, policy string) error {
+	return c.policyGroupRelationSet("LeafInterfacePolicyGroupPortSecuritySet", rnLeafPortGroup(group), "infraRsL2PortSecurityPol", "tnL2PortSecurityPolName", policy)
+}
+
+// LeafInterfaceBundleGroupLinkLevelSet attaches a link level policy to the PC/vPC interface policy group.
+func (c *Client) LeafInterfaceBundleGroupLinkLevelSet(group, policy string) error {
+	return c.policyGroupRelationSet("LeafInterfaceBundleGroupLinkLevelSet", rnLeafBundleGroup(group), "infraRsHIfPol", "tnFabricHIfPolName", policy)
+}
+
+// LeafInterfaceBundleGroupCdpSet attaches a CDP interface policy to the PC/vPC interface policy group.
+func (c *Client) LeafInterfaceBundleGroupCdpSet(group, policy string) error {
+	return c.policyGroupRelationSet("LeafInterfaceBundleGroupCdpSet", rnLeafBundleGroup(group), "infraRsCdpIfPol", "tnCdpIfPolName", policy)
+}
+
+// LeafInterfaceBundleGroupLldpSet attaches a LLDP interface policy to the PC/vPC interface policy group.
+func (c *Client) LeafInterfaceBundleGroupLldpSet(group, policy string) error {
+	return c.policyGroupRelationSet("LeafInterfaceBundleGroupLldpSet", rnLeafBundleGroup(group), "infraRsLldpIfPol", "tnLldpIfPolName", policy)
+}
+
+// LeafInterfaceBundleGroupMcpSet attaches a MCP interface policy to the PC/vPC interface policy group.
+func (c *Client) LeafInterfaceBundleGroupMcpSet(group, policy string) error {
+	return c.policyGroupRelationSet("LeafInterfaceBundleGroupMcpSet", rnLeafBundleGroup(group), "infraRsMcpIfPol", "tnMcpIfPolName", policy)
+}
+
+// LeafInterfaceBundleGroupStormControlSet attaches a storm control interface policy to the PC/vPC interface policy group.
+func (c *Client) LeafInterfaceBundleGroupStormControlSet(group, policy string) error {
+	return c.policyGroupRelationSet("LeafInterfaceBundleGroupStormControlSet", rnLeafBundleGroup(group), "infraRsStormctrlIfPol", "tnStormctrlIfPolName", policy)
+}
+
+// LeafInterfaceBundleGroupPortSecuritySet attaches a port security policy to the PC/vPC interface policy group.
+func (c *Client) LeafInterfaceBundleGroupPortSecuritySet(group, policy string) error {
+	return c.policyGroupRelationSet("LeafInterfaceBundleGroupPortSecuritySet", rnLeafBundleGroup(group), "infraRsL2PortSecurityPol", "tnL2PortSecurityPolName", policy)
+}
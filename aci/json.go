@@ -2,6 +2,7 @@ package aci
 
 import (
 	"fmt"
+	"strings"
 )
 
 func mapGet(i interface{}, member string) (interface{}, error) {
@@ -40,3 +41,37 @@ func mapString(i interface{}, member string) string {
 	}
 	return ""
 }
+
+// optionalAttr formats a JSON attribute to be appended to an attribute list.
+// An empty value means the attribute is omitted, so APIC applies the default.
+func optionalAttr(name, value string) string {
+	if value == "" {
+		return ""
+	}
+	return fmt.Sprintf(`,"%s":"%s"`, name, value)
+}
+
+// enabledDisabled: true => "enabled"
+func enabledDisabled(enable bool) string {
+	if enable {
+		return "enabled"
+	}
+	return "disabled"
+}
+
+// yesNo: true => "yes"
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+// splitFlags: "public,shared" => "public","shared"
+// Empty and "unspecified" mean no flags.
+func splitFlags(s string) []string {
+	if s == "" || s == "unspecified" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
@@ -0,0 +1,30 @@
+package aci
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestOptionalAttr(t *testing.T) {
+	optionalAttrTest(t, "speed", "10G", `,"speed":"10G"`)
+	optionalAttrTest(t, "speed", "", "")
+}
+
+func optionalAttrTest(t *testing.T, name, value, want string) {
+	if got := optionalAttr(name, value); got != want {
+		t.Errorf("name=%s value=%s want=%s got=%s", name, value, want, got)
+	}
+}
+
+func TestSplitFlags(t *testing.T) {
+	splitFlagsTest(t, "", "")
+	splitFlagsTest(t, "unspecified", "")
+	splitFlagsTest(t, "querier", "querier")
+	splitFlagsTest(t, "querier,no-default-gateway", "querier|no-default-gateway")
+}
+
+func splitFlagsTest(t *testing.T, input, want string) {
+	if got := strings.Join(splitFlags(input), "|"); got != want {
+		t.Errorf("input=%s want=%s got=%s", input, want, got)
+	}
+}
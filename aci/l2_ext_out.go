@@ -0,0 +1,210 @@
+package aci
+
+import (
+	"fmt"
+)
+
+func rnL2Out(out string) string {
+	return "l2out-" + out
+}
+
+func dnL2ExtOut(tenant, out string) string {
+	return rnTenant(tenant) + "/" + rnL2Out(out)
+}
+
+func dnL2ExtNodeProfile(tenant, out, profile string) string {
+	return dnL2ExtOut(tenant, out) + "/lnodep-" + profile
+}
+
+func dnL2ExtInterfaceProfile(tenant, out, nodeProfile, ifProfile string) string {
+	return dnL2ExtNodeProfile(tenant, out, nodeProfile) + "/lifp-" + ifProfile
+}
+
+func dnL2ExtEPG(tenant, out, epg string) string {
+	return dnL2ExtOut(tenant, out) + "/instP-" + epg
+}
+
+// L2ExtOutAdd creates a new external bridged network in a tenant, extending a bridge domain over an encapsulation.
+// Example: bridgeDomain="bd1" encap="vlan-100"
+func (c *Client) L2ExtOutAdd(tenant, out, bridgeDomain, encap, descr string) error {
+
+	rn := rnL2Out(out)
+
+	dn := dnL2ExtOut(tenant, out)
+
+	j := fmt.Sprintf(`{"l2extOut":{"attributes":{"dn":"uni/%s","name":"%s","descr":"%s","rn":"%s","status":"created"},"children":[{"l2extRsEBd":{"attributes":{"tnFvBDName":"%s","encap":"%s","status":"created,modified"}}}]}}`,
+		dn, out, descr, rn, bridgeDomain, encap)
+
+	return c.moPost("L2ExtOutAdd", dn, j)
+}
+
+// L2ExtOutDel deletes an external bridged network from a tenant.
+func (c *Client) L2ExtOutDel(tenant, out string) error {
+	return c.moChildDel("L2ExtOutDel", "fvTenant", rnTenant(tenant), "l2extOut", rnL2Out(out))
+}
+
+// L2ExtOutList retrieves the list of external bridged networks from a tenant.
+func (c *Client) L2ExtOutList(tenant string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("L2ExtOutList", rnTenant(tenant), "l2extOut")
+}
+
+// L2ExtOutBridgeDomainSet defines the bridge domain and encapsulation for an external bridged network.
+func (c *Client) L2ExtOutBridgeDomainSet(tenant, out, bridgeDomain, encap string) error {
+	j := fmt.Sprintf(`{"l2extRsEBd":{"attributes":{"tnFvBDName":"%s","encap":"%s","status":"created,modified"}}}`,
+		bridgeDomain, encap)
+	return c.moPost("L2ExtOutBridgeDomainSet", dnL2ExtOut(tenant, out), j)
+}
+
+// L2ExtOutBridgeDomainGet retrieves the bridge domain and encapsulation for an external bridged network.
+func (c *Client) L2ExtOutBridgeDomainGet(tenant, out string) (string, string, error) {
+
+	me := "L2ExtOutBridgeDomainGet"
+
+	attrs, errList := c.moChildrenAttributes(me, dnL2ExtOut(tenant, out), "l2extRsEBd")
+	if errList != nil {
+		return "", "", errList
+	}
+
+	if len(attrs) != 1 {
+		return "", "", fmt.Errorf("%s: bad attr count=%d", me, len(attrs))
+	}
+
+	return mapString(attrs[0], "tnFvBDName"), mapString(attrs[0], "encap"), nil
+}
+
+// L2ExtOutL2ExtDomainSet defines the L2 External Domain for an external bridged network.
+func (c *Client) L2ExtOutL2ExtDomainSet(tenant, out, domain string) error {
+	j := fmt.Sprintf(`{"l2extRsL2DomAtt":{"attributes":{"tDn":"uni/%s","status":"created,modified"}}}`,
+		rnL2Dom(domain))
+	return c.moPost("L2ExtOutL2ExtDomainSet", dnL2ExtOut(tenant, out), j)
+}
+
+// L2ExtOutL2ExtDomainGet retrieves the L2 External Domain for an external bridged network.
+func (c *Client) L2ExtOutL2ExtDomainGet(tenant, out string) (string, error) {
+
+	me := "L2ExtOutL2ExtDomainGet"
+
+	attrs, errList := c.moChildrenAttributes(me, dnL2ExtOut(tenant, out), "l2extRsL2DomAtt")
+	if errList != nil {
+		return "", errList
+	}
+
+	if len(attrs) != 1 {
+		return "", fmt.Errorf("%s: bad attr count=%d", me, len(attrs))
+	}
+
+	return stripPrefix(extractTail(mapString(attrs[0], "tDn")), "l2dom-"), nil
+}
+
+// L2ExtOutNodeProfileAdd creates a logical node profile in an external bridged network.
+func (c *Client) L2ExtOutNodeProfileAdd(tenant, out, profile, descr string) error {
+
+	dn := dnL2ExtNodeProfile(tenant, out, profile)
+
+	j := fmt.Sprintf(`{"l2extLNodeP":{"attributes":{"dn":"uni/%s","name":"%s","descr":"%s","rn":"lnodep-%s","status":"created"}}}`,
+		dn, profile, descr, profile)
+
+	return c.moPost("L2ExtOutNodeProfileAdd", dn, j)
+}
+
+// L2ExtOutNodeProfileDel deletes a logical node profile from an external bridged network.
+func (c *Client) L2ExtOutNodeProfileDel(tenant, out, profile string) error {
+	return c.moChildDel("L2ExtOutNodeProfileDel", "l2extOut", dnL2ExtOut(tenant, out), "l2extLNodeP", "lnodep-"+profile)
+}
+
+// L2ExtOutNodeProfileList retrieves the list of logical node profiles in an external bridged network.
+func (c *Client) L2ExtOutNodeProfileList(tenant, out string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("L2ExtOutNodeProfileList", dnL2ExtOut(tenant, out), "l2extLNodeP")
+}
+
+// L2ExtOutInterfaceProfileAdd creates a logical interface profile in a logical node profile.
+func (c *Client) L2ExtOutInterfaceProfileAdd(tenant, out, nodeProfile, ifProfile, descr string) error {
+
+	dn := dnL2ExtInterfaceProfile(tenant, out, nodeProfile, ifProfile)
+
+	j := fmt.Sprintf(`{"l2extLIfP":{"attributes":{"dn":"uni/%s","name":"%s","descr":"%s","rn":"lifp-%s","status":"created"}}}`,
+		dn, ifProfile, descr, ifProfile)
+
+	return c.moPost("L2ExtOutInterfaceProfileAdd", dn, j)
+}
+
+// L2ExtOutInterfaceProfileDel deletes a logical interface profile from a logical node profile.
+func (c *Client) L2ExtOutInterfaceProfileDel(tenant, out, nodeProfile, ifProfile string) error {
+	return c.moChildDel("L2ExtOutInterfaceProfileDel", "l2extLNodeP", dnL2ExtNodeProfile(tenant, out, nodeProfile), "l2extLIfP", "lifp-"+ifProfile)
+}
+
+// L2ExtOutInterfaceProfileList retrieves the list of logical interface profiles in a logical node profile.
+func (c *Client) L2ExtOutInterfaceProfileList(tenant, out, nodeProfile string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("L2ExtOutInterfaceProfileList", dnL2ExtNodeProfile(tenant, out, nodeProfile), "l2extLIfP")
+}
+
+// L2ExtOutInterfaceAdd attaches a port, PC or vPC, given by its path DN, to a logical interface profile.
+// Use PathPort(), PathPortChannel() or PathVPC() to build path.
+func (c *Client) L2ExtOutInterfaceAdd(tenant, out, nodeProfile, ifProfile, path string) error {
+	j := fmt.Sprintf(`{"l2extRsPathL2OutAtt":{"attributes":{"tDn":"%s","status":"created,modified"}}}`, path)
+	return c.moPost("L2ExtOutInterfaceAdd", dnL2ExtInterfaceProfile(tenant, out, nodeProfile, ifProfile), j)
+}
+
+// L2ExtOutInterfaceDel detaches a path from a logical interface profile.
+func (c *Client) L2ExtOutInterfaceDel(tenant, out, nodeProfile, ifProfile, path string) error {
+	return c.moChildDel("L2ExtOutInterfaceDel", "l2extLIfP", dnL2ExtInterfaceProfile(tenant, out, nodeProfile, ifProfile), "l2extRsPathL2OutAtt", "rspathL2OutAtt-["+path+"]")
+}
+
+// L2ExtOutInterfaceList retrieves the list of paths attached to a logical interface profile.
+// The attribute tDn holds the path DN.
+func (c *Client) L2ExtOutInterfaceList(tenant, out, nodeProfile, ifProfile string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("L2ExtOutInterfaceList", dnL2ExtInterfaceProfile(tenant, out, nodeProfile, ifProfile), "l2extRsPathL2OutAtt")
+}
+
+// L2ExtOutEPGAdd creates an external L2 EPG in an external bridged network.
+func (c *Client) L2ExtOutEPGAdd(tenant, out, epg, descr string) error {
+
+	dn := dnL2ExtEPG(tenant, out, epg)
+
+	j := fmt.Sprintf(`{"l2extInstP":{"attributes":{"dn":"uni/%s","name":"%s","descr":"%s","rn":"instP-%s","status":"created"}}}`,
+		dn, epg, descr, epg)
+
+	return c.moPost("L2ExtOutEPGAdd", dn, j)
+}
+
+// L2ExtOutEPGDel deletes an external L2 EPG from an external bridged network.
+func (c *Client) L2ExtOutEPGDel(tenant, out, epg string) error {
+	return c.moChildDel("L2ExtOutEPGDel", "l2extOut", dnL2ExtOut(tenant, out), "l2extInstP", "instP-"+epg)
+}
+
+// L2ExtOutEPGList retrieves the list of external L2 EPGs in an external bridged network.
+func (c *Client) L2ExtOutEPGList(tenant, out string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("L2ExtOutEPGList", dnL2ExtOut(tenant, out), "l2extInstP")
+}
+
+// L2ExtOutEPGContractProvidedAdd attaches contract as provided by external L2 EPG.
+func (c *Client) L2ExtOutEPGContractProvidedAdd(tenant, out, epg, contract string) error {
+	j := fmt.Sprintf(`{"fvRsProv":{"attributes":{"tnVzBrCPName":"%s","status":"created,modified"}}}`, contract)
+	return c.moPost("L2ExtOutEPGContractProvidedAdd", dnL2ExtEPG(tenant, out, epg), j)
+}
+
+// L2ExtOutEPGContractProvidedDel detaches provided contract from external L2 EPG.
+func (c *Client) L2ExtOutEPGContractProvidedDel(tenant, out, epg, contract string) error {
+	return c.moChildDel("L2ExtOutEPGContractProvidedDel", "l2extInstP", dnL2ExtEPG(tenant, out, epg), "fvRsProv", "rsprov-"+contract)
+}
+
+// L2ExtOutEPGContractProvidedList retrieves the list of contracts provided by external L2 EPG.
+func (c *Client) L2ExtOutEPGContractProvidedList(tenant, out, epg string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("L2ExtOutEPGContractProvidedList", dnL2ExtEPG(tenant, out, epg), "fvRsProv")
+}
+
+// L2ExtOutEPGContractConsumedAdd attaches contract as consumed by external L2 EPG.
+func (c *Client) L2ExtOutEPGContractConsumedAdd(tenant, out, epg, contract string) error {
+	j := fmt.Sprintf(`{"fvRsCons":{"attributes":{"tnVzBrCPName":"%s","status":"created,modified"}}}`, contract)
+	return c.moPost("L2ExtOutEPGContractConsumedAdd", dnL2ExtEPG(tenant, out, epg), j)
+}
+
+// L2ExtOutEPGContractConsumedDel detaches consumed contract from external L2 EPG.
+func (c *Client) L2ExtOutEPGContractConsumedDel(tenant, out, epg, contract string) error {
+	return c.moChildDel("L2ExtOutEPGContractConsumedDel", "l2extInstP", dnL2ExtEPG(tenant, out, epg), "fvRsCons", "rscons-"+contract)
+}
+
+// L2ExtOutEPGContractConsumedList retrieves the list of contracts consumed by external L2 EPG.
+func (c *Client) L2ExtOutEPGContractConsumedList(tenant, out, epg string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("L2ExtOutEPGContractConsumedList", dnL2ExtEPG(tenant, out, epg), "fvRsCons")
+}
@@ -259,6 +259,32 @@ func extractTail(str string) string {
 	return tail
 }
 
+// extractParent: "a/b/c" => "a/b"
+// Slashes inside brackets are not separators:
+// "uni/tn-t/ap-a/epg-e/cep-AA:BB:CC:DD:EE:FF/rscEpToPathEp-[topology/pod-1/paths-101/pathep-[eth1/1]]" => "uni/tn-t/ap-a/epg-e/cep-AA:BB:CC:DD:EE:FF"
+func extractParent(str string) string {
+	lastSlash := -1
+	depth := 0
+	for i := 0; i < len(str); i++ {
+		switch str[i] {
+		case '[':
+			depth++
+		case ']':
+			if depth > 0 {
+				depth--
+			}
+		case '/':
+			if depth == 0 {
+				lastSlash = i
+			}
+		}
+	}
+	if lastSlash < 0 {
+		return ""
+	}
+	return str[:lastSlash]
+}
+
 // stripPrefix: "xxx-abc", "xxx-" => "abc"
 func stripPrefix(s, prefix string) string {
 	if strings.HasPrefix(s, prefix) {
@@ -0,0 +1,133 @@
+package aci
+
+import (
+	"fmt"
+	"strings"
+)
+
+// External subnet scope flags for L3ExtOutEPGSubnetAdd.
+const (
+	L3ExtSubnetScopeImportSecurity = "import-security" // External subnets for the external EPG: classifies traffic into the external EPG.
+	L3ExtSubnetScopeSharedSecurity = "shared-security" // Shared security import subnet: classification is leaked to other VRFs.
+	L3ExtSubnetScopeImportRtctrl   = "import-rtctrl"   // Import route control subnet.
+	L3ExtSubnetScopeExportRtctrl   = "export-rtctrl"   // Export route control subnet.
+	L3ExtSubnetScopeSharedRtctrl   = "shared-rtctrl"   // Shared route control subnet: route is leaked to other VRFs.
+)
+
+// L3ExtSubnet holds a subnet of an external EPG (l3extSubnet).
+type L3ExtSubnet struct {
+	IP    string   // Example: "0.0.0.0/0"
+	Scope []string // L3ExtSubnetScope* flags.
+	Descr string
+}
+
+func rnL3ExtEPG(epg string) string {
+	return "instP-" + epg
+}
+
+func dnL3ExtEPG(tenant, out, epg string) string {
+	return dnL3ExtOut(tenant, out) + "/" + rnL3ExtEPG(epg)
+}
+
+func rnL3ExtSubnet(ip string) string {
+	return "extsubnet-[" + ip + "]"
+}
+
+// L3ExtOutEPGAdd creates a new external EPG (external network) in an external routed network.
+func (c *Client) L3ExtOutEPGAdd(tenant, out, epg, descr string) error {
+
+	rn := rnL3ExtEPG(epg)
+
+	dn := dnL3ExtEPG(tenant, out, epg)
+
+	j := fmt.Sprintf(`{"l3extInstP":{"attributes":{"dn":"uni/%s","name":"%s","descr":"%s","rn":"%s","status":"created"}}}`,
+		dn, epg, descr, rn)
+
+	return c.moPost("L3ExtOutEPGAdd", dn, j)
+}
+
+// L3ExtOutEPGDel deletes an external EPG from an external routed network.
+func (c *Client) L3ExtOutEPGDel(tenant, out, epg string) error {
+	return c.moChildDel("L3ExtOutEPGDel", "l3extOut", dnL3ExtOut(tenant, out), "l3extInstP", rnL3ExtEPG(epg))
+}
+
+// L3ExtOutEPGList retrieves the list of external EPGs in an external routed network.
+func (c *Client) L3ExtOutEPGList(tenant, out string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("L3ExtOutEPGList", dnL3ExtOut(tenant, out), "l3extInstP")
+}
+
+// L3ExtOutEPGSubnetAdd adds a subnet to an external EPG. Example: ip="0.0.0.0/0"
+// scope: list of L3ExtSubnetScope* flags. Empty list means default scope (L3ExtSubnetScopeImportSecurity).
+func (c *Client) L3ExtOutEPGSubnetAdd(tenant, out, epg, ip string, scope []string, descr string) error {
+
+	rn := rnL3ExtSubnet(ip)
+
+	dn := dnL3ExtEPG(tenant, out, epg) + "/" + rn
+
+	j := fmt.Sprintf(`{"l3extSubnet":{"attributes":{"dn":"uni/%s","ip":"%s","descr":"%s"%s,"rn":"%s","status":"created,modified"}}}`,
+		dn, ip, descr, optionalAttr("scope", strings.Join(scope, ",")), rn)
+
+	return c.moPost("L3ExtOutEPGSubnetAdd", dn, j)
+}
+
+// L3ExtOutEPGSubnetDel deletes a subnet from an external EPG.
+func (c *Client) L3ExtOutEPGSubnetDel(tenant, out, epg, ip string) error {
+	return c.moChildDel("L3ExtOutEPGSubnetDel", "l3extInstP", dnL3ExtEPG(tenant, out, epg), "l3extSubnet", rnL3ExtSubnet(ip))
+}
+
+// L3ExtOutEPGSubnetList retrieves the list of subnets for an external EPG.
+func (c *Client) L3ExtOutEPGSubnetList(tenant, out, epg string) ([]L3ExtSubnet, error) {
+
+	key := "l3extSubnet"
+
+	attrs, errList := c.moChildrenAttributes("L3ExtOutEPGSubnetList", dnL3ExtEPG(tenant, out, epg), key)
+	if errList != nil {
+		return nil, errList
+	}
+
+	list := make([]L3ExtSubnet, 0, len(attrs))
+	for _, attr := range attrs {
+		s := L3ExtSubnet{
+			IP:    mapString(attr, "ip"),
+			Descr: mapString(attr, "descr"),
+		}
+		if scope := mapString(attr, "scope"); scope != "" {
+			s.Scope = strings.Split(scope, ",")
+		}
+		list = append(list, s)
+	}
+
+	return list, nil
+}
+
+// L3ExtOutEPGContractProvidedAdd attaches contract as provided by external EPG.
+func (c *Client) L3ExtOutEPGContractProvidedAdd(tenant, out, epg, contract string) error {
+	j := fmt.Sprintf(`{"fvRsProv":{"attributes":{"tnVzBrCPName":"%s","status":"created,modified"}}}`, contract)
+	return c.moPost("L3ExtOutEPGContractProvidedAdd", dnL3ExtEPG(tenant, out, epg), j)
+}
+
+// L3ExtOutEPGContractProvidedDel detaches provided contract from external EPG.
+func (c *Client) L3ExtOutEPGContractProvidedDel(tenant, out, epg, contract string) error {
+	return c.moChildDel("L3ExtOutEPGContractProvidedDel", "l3extInstP", dnL3ExtEPG(tenant, out, epg), "fvRsProv", "rsprov-"+contract)
+}
+
+// L3ExtOutEPGContractProvidedList retrieves the list of contracts provided by external EPG.
+func (c *Client) L3ExtOutEPGContractProvidedList(tenant, out, epg string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("L3ExtOutEPGContractProvidedList", dnL3ExtEPG(tenant, out, epg), "fvRsProv")
+}
+
+// L3ExtOutEPGContractConsumedAdd attaches contract as consumed by external EPG.
+func (c *Client) L3ExtOutEPGContractConsumedAdd(tenant, out, epg, contract string) error {
+	j := fmt.Sprintf(`{"fvRsCons":{"attributes":{"tnVzBrCPName":"%s","status":"created,modified"}}}`, contract)
+	return c.moPost("L3ExtOutEPGContractConsumedAdd", dnL3ExtEPG(tenant, out, epg), j)
+}
+
+// L3ExtOutEPGContractConsumedDel detaches consumed contract from external EPG.
+func (c *Client) L3ExtOutEPGContractConsumedDel(tenant, out, epg, contract string) error {
+	return c.moChildDel("L3ExtOutEPGContractConsumedDel", "l3extInstP", dnL3ExtEPG(tenant, out, epg), "fvRsCons", "rscons-"+contract)
+}
+
+// L3ExtOutEPGContractConsumedList retrieves the list of contracts consumed by external EPG.
+func (c *Client) L3ExtOutEPGContractConsumedList(tenant, out, epg string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("L3ExtOutEPGContractConsumedList", dnL3ExtEPG(tenant, out, epg), "fvRsCons")
+}
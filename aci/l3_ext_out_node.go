@@ -0,0 +1,319 @@
+package aci
+
+import (
+	"fmt"
+	"strings"
+)
+
+// L3Out interface types for L3ExtInterface.
+const (
+	L3ExtIfTypeRouted       = "l3-port"       // Routed interface.
+	L3ExtIfTypeSubInterface = "sub-interface" // Routed sub-interface. Requires encap.
+	L3ExtIfTypeSVI          = "ext-svi"       // SVI. Requires encap.
+)
+
+// L3ExtNode holds a border leaf node attached to a L3Out logical node profile (l3extRsNodeL3OutAtt).
+type L3ExtNode struct {
+	Pod      string // Example: "1"
+	Node     string // Example: "101"
+	RouterID string // Example: "1.1.1.1"
+	Loopback bool   // Router ID is used as loopback address.
+}
+
+// L3ExtStaticRoute holds a static route configured on a border leaf node (ipRouteP).
+type L3ExtStaticRoute struct {
+	Prefix   string   // Example: "0.0.0.0/0"
+	NextHops []string // Example: []string{"10.0.0.1"}
+}
+
+// L3ExtInterface holds a L3Out interface in a logical interface profile (l3extRsPathL3OutAtt).
+type L3ExtInterface struct {
+	Path  string // Path DN. Use PathPort(), PathPortChannel() or PathVPC() to build it.
+	Type  string // L3ExtIfTypeRouted, L3ExtIfTypeSubInterface, L3ExtIfTypeSVI
+	Encap string // Encapsulation for sub-interface and SVI. Example: "vlan-100"
+	Addr  string // IP address. Example: "10.0.0.2/30". For vPC SVI, leave empty and use SideA/SideB.
+	MTU   string // Example: "9000", "inherit", "" (empty means default)
+	SideA string // vPC SVI only: IP address for side A. Example: "10.0.0.2/29"
+	SideB string // vPC SVI only: IP address for side B. Example: "10.0.0.3/29"
+}
+
+func rnL3ExtNodeProfile(profile string) string {
+	return "lnodep-" + profile
+}
+
+func dnL3ExtNodeProfile(tenant, out, profile string) string {
+	return dnL3ExtOut(tenant, out) + "/" + rnL3ExtNodeProfile(profile)
+}
+
+// nodeDn: pod="1" node="101" => "topology/pod-1/node-101"
+func nodeDn(pod, node string) string {
+	return "topology/pod-" + pod + "/node-" + node
+}
+
+// nodeDnParse: "topology/pod-1/node-101" => pod="1" node="101"
+func nodeDnParse(dn string) (pod, node string) {
+	for _, s := range strings.Split(dn, "/") {
+		switch {
+		case strings.HasPrefix(s, "pod-"):
+			pod = stripPrefix(s, "pod-")
+		case strings.HasPrefix(s, "node-"):
+			node = stripPrefix(s, "node-")
+		}
+	}
+	return
+}
+
+func rnL3ExtNode(pod, node string) string {
+	return "rsnodeL3OutAtt-[" + nodeDn(pod, node) + "]"
+}
+
+func dnL3ExtNode(tenant, out, profile, pod, node string) string {
+	return dnL3ExtNodeProfile(tenant, out, profile) + "/" + rnL3ExtNode(pod, node)
+}
+
+func rnL3ExtStaticRoute(prefix string) string {
+	return "rt-[" + prefix + "]"
+}
+
+func rnL3ExtInterfaceProfile(profile string) string {
+	return "lifp-" + profile
+}
+
+func dnL3ExtInterfaceProfile(tenant, out, nodeProfile, ifProfile string) string {
+	return dnL3ExtNodeProfile(tenant, out, nodeProfile) + "/" + rnL3ExtInterfaceProfile(ifProfile)
+}
+
+func rnL3ExtInterface(path string) string {
+	return "rspathL3OutAtt-[" + path + "]"
+}
+
+// L3ExtOutNodeProfileAdd creates a logical node profile in an external routed network.
+func (c *Client) L3ExtOutNodeProfileAdd(tenant, out, profile, descr string) error {
+
+	rn := rnL3ExtNodeProfile(profile)
+
+	dn := dnL3ExtNodeProfile(tenant, out, profile)
+
+	j := fmt.Sprintf(`{"l3extLNodeP":{"attributes":{"dn":"uni/%s","name":"%s","descr":"%s","rn":"%s","status":"created"}}}`,
+		dn, profile, descr, rn)
+
+	return c.moPost("L3ExtOutNodeProfileAdd", dn, j)
+}
+
+// L3ExtOutNodeProfileDel deletes a logical node profile from an external routed network.
+func (c *Client) L3ExtOutNodeProfileDel(tenant, out, profile string) error {
+	return c.moChildDel("L3ExtOutNodeProfileDel", "l3extOut", dnL3ExtOut(tenant, out), "l3extLNodeP", rnL3ExtNodeProfile(profile))
+}
+
+// L3ExtOutNodeProfileList retrieves the list of logical node profiles in an external routed network.
+func (c *Client) L3ExtOutNodeProfileList(tenant, out string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("L3ExtOutNodeProfileList", dnL3ExtOut(tenant, out), "l3extLNodeP")
+}
+
+// L3ExtOutNodeAdd attaches a border leaf node, with its router ID, to a logical node profile.
+// Example: pod="1" node="101" routerID="1.1.1.1"
+func (c *Client) L3ExtOutNodeAdd(tenant, out, profile, pod, node, routerID string, loopback bool) error {
+
+	dn := dnL3ExtNodeProfile(tenant, out, profile)
+
+	j := fmt.Sprintf(`{"l3extRsNodeL3OutAtt":{"attributes":{"tDn":"%s","rtrId":"%s","rtrIdLoopBack":"%s","status":"created,modified"}}}`,
+		nodeDn(pod, node), routerID, yesNo(loopback))
+
+	return c.moPost("L3ExtOutNodeAdd", dn, j)
+}
+
+// L3ExtOutNodeDel detaches a border leaf node from a logical node profile.
+func (c *Client) L3ExtOutNodeDel(tenant, out, profile, pod, node string) error {
+	return c.moChildDel("L3ExtOutNodeDel", "l3extLNodeP", dnL3ExtNodeProfile(tenant, out, profile), "l3extRsNodeL3OutAtt", rnL3ExtNode(pod, node))
+}
+
+// L3ExtOutNodeList retrieves the list of border leaf nodes attached to a logical node profile.
+func (c *Client) L3ExtOutNodeList(tenant, out, profile string) ([]L3ExtNode, error) {
+
+	attrs, errList := c.moChildrenAttributes("L3ExtOutNodeList", dnL3ExtNodeProfile(tenant, out, profile), "l3extRsNodeL3OutAtt")
+	if errList != nil {
+		return nil, errList
+	}
+
+	list := make([]L3ExtNode, 0, len(attrs))
+	for _, attr := range attrs {
+		pod, node := nodeDnParse(mapString(attr, "tDn"))
+		list = append(list, L3ExtNode{
+			Pod:      pod,
+			Node:     node,
+			RouterID: mapString(attr, "rtrId"),
+			Loopback: mapString(attr, "rtrIdLoopBack") == "yes",
+		})
+	}
+
+	return list, nil
+}
+
+// L3ExtOutStaticRouteAdd creates a static route on a border leaf node attached to a logical node profile.
+// Example: prefix="0.0.0.0/0" nextHops=[]string{"10.0.0.1"}
+func (c *Client) L3ExtOutStaticRouteAdd(tenant, out, profile, pod, node, prefix string, nextHops []string) error {
+
+	rn := rnL3ExtStaticRoute(prefix)
+
+	dn := dnL3ExtNode(tenant, out, profile, pod, node) + "/" + rn
+
+	children := make([]string, 0, len(nextHops))
+	for _, nh := range nextHops {
+		children = append(children, fmt.Sprintf(`{"ipNexthopP":{"attributes":{"nhAddr":"%s","status":"created,modified"}}}`, nh))
+	}
+
+	j := fmt.Sprintf(`{"ipRouteP":{"attributes":{"dn":"uni/%s","ip":"%s","rn":"%s","status":"created,modified"},"children":[%s]}}`,
+		dn, prefix, rn, strings.Join(children, ","))
+
+	return c.moPost("L3ExtOutStaticRouteAdd", dn, j)
+}
+
+// L3ExtOutStaticRouteDel deletes a static route from a border leaf node attached to a logical node profile.
+func (c *Client) L3ExtOutStaticRouteDel(tenant, out, profile, pod, node, prefix string) error {
+	return c.moChildDel("L3ExtOutStaticRouteDel", "l3extRsNodeL3OutAtt", dnL3ExtNode(tenant, out, profile, pod, node), "ipRouteP", rnL3ExtStaticRoute(prefix))
+}
+
+// L3ExtOutStaticRouteList retrieves the list of static routes on a border leaf node attached to a logical node profile.
+func (c *Client) L3ExtOutStaticRouteList(tenant, out, profile, pod, node string) ([]L3ExtStaticRoute, error) {
+
+	me := "L3ExtOutStaticRouteList"
+
+	key := "ipRouteP"
+
+	dn := dnL3ExtNode(tenant, out, profile, pod, node)
+
+	api := "/api/node/mo/uni/" + dn + ".json?query-target=children&target-subtree-class=" + key + "&rsp-subtree=children&rsp-subtree-class=ipNexthopP"
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	objs, errObj := jsonImdataObjects(c, body, me)
+	if errObj != nil {
+		return nil, errObj
+	}
+
+	var list []L3ExtStaticRoute
+	for _, obj := range objs {
+		if obj.class != key {
+			continue
+		}
+		r := L3ExtStaticRoute{Prefix: mapString(obj.attr, "ip")}
+		for _, nh := range obj.childrenByClass("ipNexthopP") {
+			r.NextHops = append(r.NextHops, mapString(nh.attr, "nhAddr"))
+		}
+		list = append(list, r)
+	}
+
+	return list, nil
+}
+
+// L3ExtOutInterfaceProfileAdd creates a logical interface profile in a logical node profile.
+func (c *Client) L3ExtOutInterfaceProfileAdd(tenant, out, nodeProfile, ifProfile, descr string) error {
+
+	rn := rnL3ExtInterfaceProfile(ifProfile)
+
+	dn := dnL3ExtInterfaceProfile(tenant, out, nodeProfile, ifProfile)
+
+	j := fmt.Sprintf(`{"l3extLIfP":{"attributes":{"dn":"uni/%s","name":"%s","descr":"%s","rn":"%s","status":"created"}}}`,
+		dn, ifProfile, descr, rn)
+
+	return c.moPost("L3ExtOutInterfaceProfileAdd", dn, j)
+}
+
+// L3ExtOutInterfaceProfileDel deletes a logical interface profile from a logical node profile.
+func (c *Client) L3ExtOutInterfaceProfileDel(tenant, out, nodeProfile, ifProfile string) error {
+	return c.moChildDel("L3ExtOutInterfaceProfileDel", "l3extLNodeP", dnL3ExtNodeProfile(tenant, out, nodeProfile), "l3extLIfP", rnL3ExtInterfaceProfile(ifProfile))
+}
+
+// L3ExtOutInterfaceProfileList retrieves the list of logical interface profiles in a logical node profile.
+func (c *Client) L3ExtOutInterfaceProfileList(tenant, out, nodeProfile string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("L3ExtOutInterfaceProfileList", dnL3ExtNodeProfile(tenant, out, nodeProfile), "l3extLIfP")
+}
+
+func l3ExtInterfaceJSON(i L3ExtInterface) string {
+
+	var members []string
+	if i.SideA != "" {
+		members = append(members, fmt.Sprintf(`{"l3extMember":{"attributes":{"side":"A","addr":"%s","status":"created,modified"}}}`, i.SideA))
+	}
+	if i.SideB != "" {
+		members = append(members, fmt.Sprintf(`{"l3extMember":{"attributes":{"side":"B","addr":"%s","status":"created,modified"}}}`, i.SideB))
+	}
+
+	attrs := optionalAttr("encap", i.Encap) + optionalAttr("addr", i.Addr) + optionalAttr("mtu", i.MTU)
+
+	return fmt.Sprintf(`{"l3extRsPathL3OutAtt":{"attributes":{"tDn":"%s","ifInstT":"%s"%s,"status":"created,modified"},"children":[%s]}}`,
+		i.Path, i.Type, attrs, strings.Join(members, ","))
+}
+
+// L3ExtOutInterfaceAdd creates a routed interface, sub-interface or SVI in a logical interface profile.
+// The interface may be placed on a port, PC or vPC. vPC SVIs take per-side addresses in SideA/SideB.
+func (c *Client) L3ExtOutInterfaceAdd(tenant, out, nodeProfile, ifProfile string, i L3ExtInterface) error {
+	return c.moPost("L3ExtOutInterfaceAdd", dnL3ExtInterfaceProfile(tenant, out, nodeProfile, ifProfile), l3ExtInterfaceJSON(i))
+}
+
+// L3ExtOutInterfaceDel deletes an interface, given by its path DN, from a logical interface profile.
+func (c *Client) L3ExtOutInterfaceDel(tenant, out, nodeProfile, ifProfile, path string) error {
+	return c.moChildDel("L3ExtOutInterfaceDel", "l3extLIfP", dnL3ExtInterfaceProfile(tenant, out, nodeProfile, ifProfile), "l3extRsPathL3OutAtt", rnL3ExtInterface(path))
+}
+
+// L3ExtOutInterfaceList retrieves the list of interfaces in a logical interface profile.
+func (c *Client) L3ExtOutInterfaceList(tenant, out, nodeProfile, ifProfile string) ([]L3ExtInterface, error) {
+
+	me := "L3ExtOutInterfaceList"
+
+	key := "l3extRsPathL3OutAtt"
+
+	dn := dnL3ExtInterfaceProfile(tenant, out, nodeProfile, ifProfile)
+
+	api := "/api/node/mo/uni/" + dn + ".json?query-target=children&target-subtree-class=" + key + "&rsp-subtree=children&rsp-subtree-class=l3extMember"
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	objs, errObj := jsonImdataObjects(c, body, me)
+	if errObj != nil {
+		return nil, errObj
+	}
+
+	var list []L3ExtInterface
+	for _, obj := range objs {
+		if obj.class != key {
+			continue
+		}
+		i := L3ExtInterface{
+			Path:  mapString(obj.attr, "tDn"),
+			Type:  mapString(obj.attr, "ifInstT"),
+			Encap: mapString(obj.attr, "encap"),
+			Addr:  mapString(obj.attr, "addr"),
+			MTU:   mapString(obj.attr, "mtu"),
+		}
+		for _, m := range obj.childrenByClass("l3extMember") {
+			switch mapString(m.attr, "side") {
+			case "A":
+				i.SideA = mapString(m.attr, "addr")
+			case "B":
+				i.SideB = mapString(m.attr, "addr")
+			}
+		}
+		list = append(list, i)
+	}
+
+	return list, nil
+}
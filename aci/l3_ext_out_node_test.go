@@ -0,0 +1,36 @@
+package aci
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestNodeDnParse(t *testing.T) {
+	dn := nodeDn("1", "101")
+	if dn != "topology/pod-1/node-101" {
+		t.Errorf("unexpected node dn: %s", dn)
+	}
+	pod, node := nodeDnParse(dn)
+	if pod != "1" || node != "101" {
+		t.Errorf("dn=%s want=1/101 got=%s/%s", dn, pod, node)
+	}
+}
+
+func TestL3ExtInterfaceJSON(t *testing.T) {
+	l3ExtInterfaceJSONTest(t, L3ExtInterface{Path: PathPort("1", "101", "eth1/1"), Type: L3ExtIfTypeRouted, Addr: "10.0.0.2/30"}, 0)
+	l3ExtInterfaceJSONTest(t, L3ExtInterface{Path: PathVPC("1", "101", "102", "vpc1"), Type: L3ExtIfTypeSVI, Encap: "vlan-100", MTU: "9000", SideA: "10.0.0.2/29", SideB: "10.0.0.3/29"}, 2)
+}
+
+func l3ExtInterfaceJSONTest(t *testing.T, i L3ExtInterface, wantMembers int) {
+	j := l3ExtInterfaceJSON(i)
+	if !json.Valid([]byte(j)) {
+		t.Errorf("invalid json: %s", j)
+	}
+	if got := strings.Count(j, `"l3extMember"`); got != wantMembers {
+		t.Errorf("json=%s want members=%d got=%d", j, wantMembers, got)
+	}
+	if i.Addr == "" && strings.Contains(j, `"addr":""`) {
+		t.Errorf("json=%s unexpected empty addr", j)
+	}
+}
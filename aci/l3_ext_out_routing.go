@@ -0,0 +1,206 @@
+package aci
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OSPF area types for L3ExtOutOSPFEnable.
+const (
+	OSPFAreaTypeRegular = "regular"
+	OSPFAreaTypeStub    = "stub"
+	OSPFAreaTypeNSSA    = "nssa"
+)
+
+// BGP peer control flags for BGPPeer.Ctrl.
+const (
+	BGPPeerCtrlAllowSelfAS      = "allow-self-as"
+	BGPPeerCtrlASOverride       = "as-override"
+	BGPPeerCtrlDisablePeerCheck = "dis-peer-as-check"
+	BGPPeerCtrlNextHopSelf      = "nh-self"
+	BGPPeerCtrlSendCommunity    = "send-com"
+	BGPPeerCtrlSendExtCommunity = "send-ext-com"
+)
+
+// BGPPeer holds a BGP peer connectivity profile (bgpPeerP).
+type BGPPeer struct {
+	Addr               string   // Peer IP address. Example: "10.0.0.1"
+	RemoteASN          string   // Example: "65001"
+	LocalASN           string   // Local AS number presented to the peer. Empty means the fabric AS number.
+	LocalASNConfig     string   // "no-prepend", "dual-as", "replace-as", "" (empty means default)
+	Password           string   // Empty means no authentication. Never returned by APIC.
+	Ctrl               []string // BGPPeerCtrl* flags.
+	AllowedSelfASCount string   // Number of times the local AS may appear in the AS path, with BGPPeerCtrlAllowSelfAS. Example: "3"
+	TTL                string   // eBGP multihop TTL. Example: "2"
+	Descr              string
+}
+
+func rnBGPPeer(addr string) string {
+	return "peerP-[" + addr + "]"
+}
+
+func bgpPeerJSON(p BGPPeer) string {
+
+	attrs := optionalAttr("ctrl", strings.Join(p.Ctrl, ",")) +
+		optionalAttr("allowedSelfAsCnt", p.AllowedSelfASCount) +
+		optionalAttr("password", p.Password) +
+		optionalAttr("ttl", p.TTL)
+
+	children := []string{
+		fmt.Sprintf(`{"bgpAsP":{"attributes":{"asn":"%s","status":"created,modified"}}}`, p.RemoteASN),
+	}
+	if p.LocalASN != "" {
+		children = append(children, fmt.Sprintf(`{"bgpLocalAsnP":{"attributes":{"localAsn":"%s"%s,"status":"created,modified"}}}`,
+			p.LocalASN, optionalAttr("asnPropagate", p.LocalASNConfig)))
+	}
+
+	return fmt.Sprintf(`{"bgpPeerP":{"attributes":{"addr":"%s","descr":"%s"%s,"status":"created,modified"},"children":[%s]}}`,
+		p.Addr, p.Descr, attrs, strings.Join(children, ","))
+}
+
+func bgpPeerFromObject(obj imdataObject) BGPPeer {
+
+	p := BGPPeer{
+		Addr:               mapString(obj.attr, "addr"),
+		AllowedSelfASCount: mapString(obj.attr, "allowedSelfAsCnt"),
+		TTL:                mapString(obj.attr, "ttl"),
+		Descr:              mapString(obj.attr, "descr"),
+	}
+
+	if ctrl := mapString(obj.attr, "ctrl"); ctrl != "" {
+		p.Ctrl = strings.Split(ctrl, ",")
+	}
+
+	for _, as := range obj.childrenByClass("bgpAsP") {
+		p.RemoteASN = mapString(as.attr, "asn")
+	}
+
+	for _, as := range obj.childrenByClass("bgpLocalAsnP") {
+		p.LocalASN = mapString(as.attr, "localAsn")
+		p.LocalASNConfig = mapString(as.attr, "asnPropagate")
+	}
+
+	return p
+}
+
+// bgpPeerList retrieves the BGP peer connectivity profiles under the managed object given by parentDn.
+func (c *Client) bgpPeerList(me, parentDn string) ([]BGPPeer, error) {
+
+	key := "bgpPeerP"
+
+	api := "/api/node/mo/uni/" + parentDn + ".json?query-target=children&target-subtree-class=" + key + "&rsp-subtree=children&rsp-subtree-class=bgpAsP,bgpLocalAsnP"
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	objs, errObj := jsonImdataObjects(c, body, me)
+	if errObj != nil {
+		return nil, errObj
+	}
+
+	var list []BGPPeer
+	for _, obj := range objs {
+		if obj.class == key {
+			list = append(list, bgpPeerFromObject(obj))
+		}
+	}
+
+	return list, nil
+}
+
+// L3ExtOutBGPEnable enables BGP on an external routed network.
+func (c *Client) L3ExtOutBGPEnable(tenant, out string) error {
+	j := `{"bgpExtP":{"attributes":{"status":"created,modified"}}}`
+	return c.moPost("L3ExtOutBGPEnable", dnL3ExtOut(tenant, out), j)
+}
+
+// L3ExtOutBGPDisable disables BGP on an external routed network.
+func (c *Client) L3ExtOutBGPDisable(tenant, out string) error {
+	return c.moChildDel("L3ExtOutBGPDisable", "l3extOut", dnL3ExtOut(tenant, out), "bgpExtP", "bgpExtP")
+}
+
+// L3ExtOutOSPFEnable enables OSPF on an external routed network.
+// Example: areaID="0.0.0.1" areaType=OSPFAreaTypeNSSA
+func (c *Client) L3ExtOutOSPFEnable(tenant, out, areaID, areaType string) error {
+	j := fmt.Sprintf(`{"ospfExtP":{"attributes":{"areaId":"%s"%s,"status":"created,modified"}}}`,
+		areaID, optionalAttr("areaType", areaType))
+	return c.moPost("L3ExtOutOSPFEnable", dnL3ExtOut(tenant, out), j)
+}
+
+// L3ExtOutOSPFDisable disables OSPF on an external routed network.
+func (c *Client) L3ExtOutOSPFDisable(tenant, out string) error {
+	return c.moChildDel("L3ExtOutOSPFDisable", "l3extOut", dnL3ExtOut(tenant, out), "ospfExtP", "ospfExtP")
+}
+
+// L3ExtOutOSPFGet retrieves the OSPF area ID and area type for an external routed network.
+func (c *Client) L3ExtOutOSPFGet(tenant, out string) (string, string, error) {
+
+	me := "L3ExtOutOSPFGet"
+
+	attrs, errList := c.moChildrenAttributes(me, dnL3ExtOut(tenant, out), "ospfExtP")
+	if errList != nil {
+		return "", "", errList
+	}
+
+	if len(attrs) != 1 {
+		return "", "", fmt.Errorf("%s: bad attr count=%d", me, len(attrs))
+	}
+
+	return mapString(attrs[0], "areaId"), mapString(attrs[0], "areaType"), nil
+}
+
+// L3ExtOutNodeBGPPeerAdd creates a BGP peer connectivity profile at logical node profile level.
+// The peering is sourced from the node loopback address.
+func (c *Client) L3ExtOutNodeBGPPeerAdd(tenant, out, nodeProfile string, peer BGPPeer) error {
+	return c.moPost("L3ExtOutNodeBGPPeerAdd", dnL3ExtNodeProfile(tenant, out, nodeProfile), bgpPeerJSON(peer))
+}
+
+// L3ExtOutNodeBGPPeerDel deletes a BGP peer connectivity profile from logical node profile level.
+func (c *Client) L3ExtOutNodeBGPPeerDel(tenant, out, nodeProfile, addr string) error {
+	return c.moChildDel("L3ExtOutNodeBGPPeerDel", "l3extLNodeP", dnL3ExtNodeProfile(tenant, out, nodeProfile), "bgpPeerP", rnBGPPeer(addr))
+}
+
+// L3ExtOutNodeBGPPeerList retrieves the list of BGP peer connectivity profiles at logical node profile level.
+func (c *Client) L3ExtOutNodeBGPPeerList(tenant, out, nodeProfile string) ([]BGPPeer, error) {
+	return c.bgpPeerList("L3ExtOutNodeBGPPeerList", dnL3ExtNodeProfile(tenant, out, nodeProfile))
+}
+
+// L3ExtOutInterfaceBGPPeerAdd creates a BGP peer connectivity profile at interface level.
+// The peering is sourced from the interface, given by its path DN, created by L3ExtOutInterfaceAdd().
+func (c *Client) L3ExtOutInterfaceBGPPeerAdd(tenant, out, nodeProfile, ifProfile, path string, peer BGPPeer) error {
+	dn := dnL3ExtInterfaceProfile(tenant, out, nodeProfile, ifProfile) + "/" + rnL3ExtInterface(path)
+	return c.moPost("L3ExtOutInterfaceBGPPeerAdd", dn, bgpPeerJSON(peer))
+}
+
+// L3ExtOutInterfaceBGPPeerDel deletes a BGP peer connectivity profile from interface level.
+func (c *Client) L3ExtOutInterfaceBGPPeerDel(tenant, out, nodeProfile, ifProfile, path, addr string) error {
+	dn := dnL3ExtInterfaceProfile(tenant, out, nodeProfile, ifProfile) + "/" + rnL3ExtInterface(path)
+	return c.moChildDel("L3ExtOutInterfaceBGPPeerDel", "l3extRsPathL3OutAtt", dn, "bgpPeerP", rnBGPPeer(addr))
+}
+
+// L3ExtOutInterfaceBGPPeerList retrieves the list of BGP peer connectivity profiles at interface level.
+func (c *Client) L3ExtOutInterfaceBGPPeerList(tenant, out, nodeProfile, ifProfile, path string) ([]BGPPeer, error) {
+	dn := dnL3ExtInterfaceProfile(tenant, out, nodeProfile, ifProfile) + "/" + rnL3ExtInterface(path)
+	return c.bgpPeerList("L3ExtOutInterfaceBGPPeerList", dn)
+}
+
+// L3ExtOutInterfaceProfileOSPFSet enables OSPF on a logical interface profile using an OSPF interface policy.
+// authKey is the OSPF authentication key. Empty means no authentication.
+func (c *Client) L3ExtOutInterfaceProfileOSPFSet(tenant, out, nodeProfile, ifProfile, policy, authKey string) error {
+	j := fmt.Sprintf(`{"ospfIfP":{"attributes":{"status":"created,modified"%s},"children":[{"ospfRsIfPol":{"attributes":{"tnOspfIfPolName":"%s","status":"created,modified"}}}]}}`,
+		optionalAttr("authKey", authKey), policy)
+	return c.moPost("L3ExtOutInterfaceProfileOSPFSet", dnL3ExtInterfaceProfile(tenant, out, nodeProfile, ifProfile), j)
+}
+
+// L3ExtOutInterfaceProfileOSPFDel disables OSPF on a logical interface profile.
+func (c *Client) L3ExtOutInterfaceProfileOSPFDel(tenant, out, nodeProfile, ifProfile string) error {
+	return c.moChildDel("L3ExtOutInterfaceProfileOSPFDel", "l3extLIfP", dnL3ExtInterfaceProfile(tenant, out, nodeProfile, ifProfile), "ospfIfP", "ospfIfP")
+}
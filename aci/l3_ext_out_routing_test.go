@@ -0,0 +1,23 @@
+package aci
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestBGPPeerJSON(t *testing.T) {
+	bgpPeerJSONTest(t, BGPPeer{Addr: "10.0.0.1", RemoteASN: "65001"}, 1)
+	bgpPeerJSONTest(t, BGPPeer{Addr: "10.0.0.1", RemoteASN: "65001", LocalASN: "65100", LocalASNConfig: "no-prepend", Ctrl: []string{BGPPeerCtrlAllowSelfAS, BGPPeerCtrlSendCommunity}, AllowedSelfASCount: "3"}, 2)
+}
+
+func bgpPeerJSONTest(t *testing.T, p BGPPeer, wantChildren int) {
+	j := bgpPeerJSON(p)
+	if !json.Valid([]byte(j)) {
+		t.Errorf("invalid json: %s", j)
+	}
+	children := strings.Count(j, `"bgpAsP"`) + strings.Count(j, `"bgpLocalAsnP"`)
+	if children != wantChildren {
+		t.Errorf("json=%s want children=%d got=%d", j, wantChildren, children)
+	}
+}
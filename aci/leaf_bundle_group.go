@@ -0,0 +1,140 @@
+package aci
+
+import (
+	"fmt"
+)
+
+// Bundle types for leaf PC/vPC interface policy groups.
+const (
+	BundleTypePC  = "link" // Port channel.
+	BundleTypeVPC = "node" // Virtual port channel.
+)
+
+func rnLeafBundleGroup(group string) string {
+	return "accbundle-" + group
+}
+
+// LeafInterfaceBundleGroupAdd creates a PC/vPC interface policy group for leaf ports.
+// lagType: BundleTypePC, BundleTypeVPC
+func (c *Client) LeafInterfaceBundleGroupAdd(group, lagType, descr string) error {
+
+	me := "LeafInterfaceBundleGroupAdd"
+
+	rn := rnLeafBundleGroup(group)
+
+	j := fmt.Sprintf(`{"infraAccBndlGrp":{"attributes":{"dn":"uni/infra/funcprof/%s","name":"%s","descr":"%s","lagT":"%s","rn":"%s","status":"created"}}}`,
+		rn, group, descr, lagType, rn)
+
+	return c.moPost(me, "infra/funcprof/"+rn, j)
+}
+
+// LeafInterfaceBundleGroupDel deletes a PC/vPC interface policy group for leaf ports.
+func (c *Client) LeafInterfaceBundleGroupDel(group string) error {
+
+	me := "LeafInterfaceBundleGroupDel"
+
+	rn := rnLeafBundleGroup(group)
+
+	j := fmt.Sprintf(`{"infraFuncP":{"attributes":{"dn":"uni/infra/funcprof","status":"modified"},"children":[{"infraAccBndlGrp":{"attributes":{"dn":"uni/infra/funcprof/%s","status":"deleted"}}}]}}`,
+		rn)
+
+	return c.moPost(me, "infra/funcprof", j)
+}
+
+// LeafInterfaceBundleGroupList retrieves the list of PC/vPC interface policy groups for leaf ports.
+// The attribute lagT tells PC (BundleTypePC) from vPC (BundleTypeVPC).
+func (c *Client) LeafInterfaceBundleGroupList() ([]map[string]interface{}, error) {
+
+	me := "LeafInterfaceBundleGroupList"
+
+	key := "infraAccBndlGrp"
+
+	api := "/api/node/class/" + key + ".json"
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return jsonImdataAttributes(c, body, key, me)
+}
+
+// LeafInterfaceBundleGroupEntitySet attaches an AAEP to the PC/vPC interface policy group.
+func (c *Client) LeafInterfaceBundleGroupEntitySet(group, aep string) error {
+
+	me := "LeafInterfaceBundleGroupEntitySet"
+
+	rnG := rnLeafBundleGroup(group)
+	rnE := rnAEP(aep)
+
+	j := fmt.Sprintf(`{"infraRsAttEntP":{"attributes":{"tDn":"uni/infra/%s","status":"created,modified"}}}`,
+		rnE)
+
+	return c.moPost(me, "infra/funcprof/"+rnG+"/rsattEntP", j)
+}
+
+// LeafInterfaceBundleGroupEntityGet gets the AAEP attached to the PC/vPC interface policy group.
+func (c *Client) LeafInterfaceBundleGroupEntityGet(group string) (string, error) {
+
+	me := "LeafInterfaceBundleGroupEntityGet"
+
+	key := "infraRsAttEntP"
+
+	rnG := rnLeafBundleGroup(group)
+
+	attrs, errAttr := c.moChildrenAttributes(me, "infra/funcprof/"+rnG, key)
+	if errAttr != nil {
+		return "", errAttr
+	}
+
+	if len(attrs) != 1 {
+		return "", fmt.Errorf("%s: bad attr count=%d", me, len(attrs))
+	}
+
+	aep := mapString(attrs[0], "tDn")
+	if aep == "" {
+		return "", fmt.Errorf("%s: empty AAEP", me)
+	}
+
+	return stripPrefix(extractTail(aep), "attentp-"), nil
+}
+
+// LeafInterfaceBundleGroupLacpSet attaches a LACP policy to the PC/vPC interface policy group.
+func (c *Client) LeafInterfaceBundleGroupLacpSet(group, policy string) error {
+
+	me := "LeafInterfaceBundleGroupLacpSet"
+
+	rnG := rnLeafBundleGroup(group)
+
+	j := fmt.Sprintf(`{"infraRsLacpPol":{"attributes":{"tnLacpLagPolName":"%s","status":"created,modified"}}}`,
+		policy)
+
+	return c.moPost(me, "infra/funcprof/"+rnG, j)
+}
+
+// LeafInterfaceBundleGroupLacpGet gets the LACP policy attached to the PC/vPC interface policy group.
+func (c *Client) LeafInterfaceBundleGroupLacpGet(group string) (string, error) {
+
+	me := "LeafInterfaceBundleGroupLacpGet"
+
+	key := "infraRsLacpPol"
+
+	rnG := rnLeafBundleGroup(group)
+
+	attrs, errAttr := c.moChildrenAttributes(me, "infra/funcprof/"+rnG, key)
+	if errAttr != nil {
+		return "", errAttr
+	}
+
+	if len(attrs) != 1 {
+		return "", fmt.Errorf("%s: bad attr count=%d", me, len(attrs))
+	}
+
+	return mapString(attrs[0], "tnLacpLagPolName"), nil
+}
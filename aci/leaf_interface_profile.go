@@ -0,0 +1,253 @@
+package aci
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+func rnLeafInterfaceProfile(profile string) string {
+	return "accportprof-" + profile
+}
+
+func dnLeafInterfaceProfile(profile string) string {
+	return "infra/" + rnLeafInterfaceProfile(profile)
+}
+
+func rnLeafInterfaceSelector(selector string) string {
+	return "hports-" + selector + "-typ-range"
+}
+
+func dnLeafInterfaceSelector(profile, selector string) string {
+	return dnLeafInterfaceProfile(profile) + "/" + rnLeafInterfaceSelector(selector)
+}
+
+func rnPortBlock(block string) string {
+	return "portblk-" + block
+}
+
+// portBlockSplit: "1/1-48" => "1","1","1","48"
+// Accepted formats: "1/10", "1/1-48", "1/47-2/2"
+// Card and port values must be numbers, and the block must not end before it starts.
+func portBlockSplit(ports string) (fromCard, toCard, fromPort, toPort string, err error) {
+
+	from := ports
+	var to string
+	if dash := strings.IndexByte(ports, '-'); dash >= 0 {
+		from = ports[:dash]
+		to = ports[dash+1:]
+		if to == "" || strings.IndexByte(to, '-') >= 0 {
+			return "", "", "", "", fmt.Errorf("bad port block: '%s'", ports)
+		}
+	}
+
+	slash := strings.IndexByte(from, '/')
+	if slash < 1 || slash == len(from)-1 {
+		return "", "", "", "", fmt.Errorf("bad port block: '%s'", ports)
+	}
+	fromCard = from[:slash]
+	fromPort = from[slash+1:]
+
+	switch slash = strings.IndexByte(to, '/'); {
+	case to == "":
+		toCard, toPort = fromCard, fromPort // single port
+	case slash < 0:
+		toCard, toPort = fromCard, to // range in same card
+	case slash < 1 || slash == len(to)-1:
+		return "", "", "", "", fmt.Errorf("bad port block: '%s'", ports)
+	default:
+		toCard = to[:slash]
+		toPort = to[slash+1:]
+	}
+
+	var n [4]int
+	for i, v := range []string{fromCard, fromPort, toCard, toPort} {
+		var errConv error
+		if n[i], errConv = strconv.Atoi(v); errConv != nil || n[i] < 0 {
+			return "", "", "", "", fmt.Errorf("bad port block: '%s': bad number: '%s'", ports, v)
+		}
+	}
+	if n[0] > n[2] || (n[0] == n[2] && n[1] > n[3]) {
+		return "", "", "", "", fmt.Errorf("bad port block: '%s': ends before start", ports)
+	}
+
+	return fromCard, toCard, fromPort, toPort, nil
+}
+
+// LeafInterfaceProfileAdd creates a leaf interface profile.
+func (c *Client) LeafInterfaceProfileAdd(profile, descr string) error {
+
+	me := "LeafInterfaceProfileAdd"
+
+	rn := rnLeafInterfaceProfile(profile)
+	dn := dnLeafInterfaceProfile(profile)
+
+	j := fmt.Sprintf(`{"infraAccPortP":{"attributes":{"dn":"uni/%s","name":"%s","descr":"%s","rn":"%s","status":"created"}}}`,
+		dn, profile, descr, rn)
+
+	return c.moPost(me, dn, j)
+}
+
+// LeafInterfaceProfileDel deletes a leaf interface profile.
+func (c *Client) LeafInterfaceProfileDel(profile string) error {
+
+	me := "LeafInterfaceProfileDel"
+
+	dn := dnLeafInterfaceProfile(profile)
+
+	j := fmt.Sprintf(`{"infraInfra":{"attributes":{"dn":"uni/infra","status":"modified"},"children":[{"infraAccPortP":{"attributes":{"dn":"uni/%s","status":"deleted"}}}]}}`,
+		dn)
+
+	return c.moPost(me, "infra", j)
+}
+
+// LeafInterfaceProfileList retrieves the list of leaf interface profiles.
+func (c *Client) LeafInterfaceProfileList() ([]map[string]interface{}, error) {
+
+	me := "LeafInterfaceProfileList"
+
+	key := "infraAccPortP"
+
+	api := "/api/node/class/" + key + ".json"
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return jsonImdataAttributes(c, body, key, me)
+}
+
+// LeafInterfaceSelectorAdd creates a port selector in a leaf interface profile.
+// The selector points to the leaf access port policy group created by LeafInterfacePolicyGroupAdd().
+func (c *Client) LeafInterfaceSelectorAdd(profile, selector, group, descr string) error {
+
+	me := "LeafInterfaceSelectorAdd"
+
+	rn := rnLeafInterfaceSelector(selector)
+	dn := dnLeafInterfaceSelector(profile, selector)
+	rnG := rnLeafPortGroup(group)
+
+	j := fmt.Sprintf(`{"infraHPortS":{"attributes":{"dn":"uni/%s","name":"%s","descr":"%s","type":"range","rn":"%s","status":"created"},"children":[{"infraRsAccBaseGrp":{"attributes":{"tDn":"uni/infra/funcprof/%s","status":"created,modified"}}}]}}`,
+		dn, selector, descr, rn, rnG)
+
+	return c.moPost(me, dn, j)
+}
+
+// LeafInterfaceSelectorDel deletes a port selector from a leaf interface profile.
+func (c *Client) LeafInterfaceSelectorDel(profile, selector string) error {
+
+	me := "LeafInterfaceSelectorDel"
+
+	dnP := dnLeafInterfaceProfile(profile)
+	dn := dnLeafInterfaceSelector(profile, selector)
+
+	j := fmt.Sprintf(`{"infraAccPortP":{"attributes":{"dn":"uni/%s","status":"modified"},"children":[{"infraHPortS":{"attributes":{"dn":"uni/%s","status":"deleted"}}}]}}`,
+		dnP, dn)
+
+	return c.moPost(me, dnP, j)
+}
+
+// LeafInterfaceSelectorList retrieves the list of port selectors from a leaf interface profile.
+func (c *Client) LeafInterfaceSelectorList(profile string) ([]map[string]interface{}, error) {
+
+	me := "LeafInterfaceSelectorList"
+
+	key := "infraHPortS"
+
+	dnP := dnLeafInterfaceProfile(profile)
+
+	return c.moChildrenAttributes(me, dnP, key)
+}
+
+// LeafInterfaceSelectorGroupGet retrieves the DN of the policy group a port selector points to.
+func (c *Client) LeafInterfaceSelectorGroupGet(profile, selector string) (string, error) {
+
+	me := "LeafInterfaceSelectorGroupGet"
+
+	key := "infraRsAccBaseGrp"
+
+	dn := dnLeafInterfaceSelector(profile, selector)
+
+	attrs, errAttr := c.moChildrenAttributes(me, dn, key)
+	if errAttr != nil {
+		return "", errAttr
+	}
+
+	if len(attrs) < 1 {
+		return "", fmt.Errorf("%s: empty list of policy groups", me)
+	}
+
+	group := mapString(attrs[0], "tDn")
+	if group == "" {
+		return "", fmt.Errorf("%s: empty policy group", me)
+	}
+
+	return group, nil
+}
+
+// LeafInterfacePortBlockAdd creates a port block in a port selector.
+// ports: "1/10" (single port), "1/1-48" (range in card 1), "1/47-2/2" (range across cards)
+func (c *Client) LeafInterfacePortBlockAdd(profile, selector, block, ports string) error {
+
+	me := "LeafInterfacePortBlockAdd"
+
+	fromCard, toCard, fromPort, toPort, errPorts := portBlockSplit(ports)
+	if errPorts != nil {
+		return fmt.Errorf("%s: %v", me, errPorts)
+	}
+
+	dnS := dnLeafInterfaceSelector(profile, selector)
+	rn := rnPortBlock(block)
+
+	j := fmt.Sprintf(`{"infraPortBlk":{"attributes":{"dn":"uni/%s/%s","name":"%s","fromCard":"%s","toCard":"%s","fromPort":"%s","toPort":"%s","rn":"%s","status":"created,modified"}}}`,
+		dnS, rn, block, fromCard, toCard, fromPort, toPort, rn)
+
+	return c.moPost(me, dnS+"/"+rn, j)
+}
+
+// LeafInterfacePortBlockDel deletes a port block from a port selector.
+func (c *Client) LeafInterfacePortBlockDel(profile, selector, block string) error {
+
+	me := "LeafInterfacePortBlockDel"
+
+	dnS := dnLeafInterfaceSelector(profile, selector)
+	rn := rnPortBlock(block)
+
+	j := fmt.Sprintf(`{"infraHPortS":{"attributes":{"dn":"uni/%s","status":"modified"},"children":[{"infraPortBlk":{"attributes":{"dn":"uni/%s/%s","status":"deleted"}}}]}}`,
+		dnS, dnS, rn)
+
+	return c.moPost(me, dnS, j)
+}
+
+// LeafInterfacePortBlockList retrieves the list of port blocks from a port selector.
+func (c *Client) LeafInterfacePortBlockList(profile, selector string) ([]map[string]interface{}, error) {
+
+	me := "LeafInterfacePortBlockList"
+
+	key := "infraPortBlk"
+
+	dnS := dnLeafInterfaceSelector(profile, selector)
+
+	return c.moChildrenAttributes(me, dnS, key)
+}
+
+// LeafInterfaceSelectorBundleGroupSet points a port selector to the PC/vPC interface policy group created by LeafInterfaceBundleGroupAdd().
+func (c *Client) LeafInterfaceSelectorBundleGroupSet(profile, selector, group string) error {
+
+	me := "LeafInterfaceSelectorBundleGroupSet"
+
+	dn := dnLeafInterfaceSelector(profile, selector)
+	rnG := rnLeafBundleGroup(group)
+
+	j := fmt.Sprintf(`{"infraRsAccBaseGrp":{"attributes":{"tDn":"uni/infra/funcprof/%s","status":"created,modified"}}}`,
+		rnG)
+
+	return c.moPost(me, dn+"/rsaccBaseGrp", j)
+}
@@ -0,0 +1,30 @@
+package aci
+
+import (
+	"testing"
+)
+
+func TestPortBlockSplit(t *testing.T) {
+	portBlockSplitTest(t, "1/10", "1", "1", "10", "10")
+	portBlockSplitTest(t, "1/1-48", "1", "1", "1", "48")
+	portBlockSplitTest(t, "1/1-1/48", "1", "1", "1", "48")
+	portBlockSplitTest(t, "1/47-2/2", "1", "2", "47", "2")
+	portBlockSplitTest(t, "1/5-5", "1", "1", "5", "5")
+
+	for _, bad := range []string{"", "10", "/10", "1/", "1/1-/2", "1/1-2/", "-1/1", "1/1-", "1/1-2-3", "a/b-c", "1/a", "x/1", "1/1-b", "1/1-2/x", "1/48-1", "2/1-1/48"} {
+		if _, _, _, _, err := portBlockSplit(bad); err == nil {
+			t.Errorf("input=%s expected error", bad)
+		}
+	}
+}
+
+func portBlockSplitTest(t *testing.T, input, wantFromCard, wantToCard, wantFromPort, wantToPort string) {
+	fromCard, toCard, fromPort, toPort, err := portBlockSplit(input)
+	if err != nil {
+		t.Errorf("input=%s error: %v", input, err)
+		return
+	}
+	if fromCard != wantFromCard || toCard != wantToCard || fromPort != wantFromPort || toPort != wantToPort {
+		t.Errorf("input=%s want=%s/%s-%s/%s got=%s/%s-%s/%s", input, wantFromCard, wantFromPort, wantToCard, wantToPort, fromCard, fromPort, toCard, toPort)
+	}
+}
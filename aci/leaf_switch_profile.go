@@ -0,0 +1,196 @@
+package aci
+
+import (
+	"fmt"
+)
+
+func rnLeafSwitchProfile(profile string) string {
+	return "nprof-" + profile
+}
+
+func dnLeafSwitchProfile(profile string) string {
+	return "infra/" + rnLeafSwitchProfile(profile)
+}
+
+func rnLeafSwitchSelector(selector string) string {
+	return "leaves-" + selector + "-typ-range"
+}
+
+func dnLeafSwitchSelector(profile, selector string) string {
+	return dnLeafSwitchProfile(profile) + "/" + rnLeafSwitchSelector(selector)
+}
+
+func rnNodeBlock(block string) string {
+	return "nodeblk-" + block
+}
+
+// LeafSwitchProfileAdd creates a leaf switch profile.
+func (c *Client) LeafSwitchProfileAdd(profile, descr string) error {
+
+	me := "LeafSwitchProfileAdd"
+
+	rn := rnLeafSwitchProfile(profile)
+	dn := dnLeafSwitchProfile(profile)
+
+	j := fmt.Sprintf(`{"infraNodeP":{"attributes":{"dn":"uni/%s","name":"%s","descr":"%s","rn":"%s","status":"created"}}}`,
+		dn, profile, descr, rn)
+
+	return c.moPost(me, dn, j)
+}
+
+// LeafSwitchProfileDel deletes a leaf switch profile.
+func (c *Client) LeafSwitchProfileDel(profile string) error {
+
+	me := "LeafSwitchProfileDel"
+
+	dn := dnLeafSwitchProfile(profile)
+
+	j := fmt.Sprintf(`{"infraInfra":{"attributes":{"dn":"uni/infra","status":"modified"},"children":[{"infraNodeP":{"attributes":{"dn":"uni/%s","status":"deleted"}}}]}}`,
+		dn)
+
+	return c.moPost(me, "infra", j)
+}
+
+// LeafSwitchProfileList retrieves the list of leaf switch profiles.
+func (c *Client) LeafSwitchProfileList() ([]map[string]interface{}, error) {
+
+	me := "LeafSwitchProfileList"
+
+	key := "infraNodeP"
+
+	api := "/api/node/class/" + key + ".json"
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return jsonImdataAttributes(c, body, key, me)
+}
+
+// LeafSwitchSelectorAdd creates a leaf selector in a leaf switch profile.
+func (c *Client) LeafSwitchSelectorAdd(profile, selector, descr string) error {
+
+	me := "LeafSwitchSelectorAdd"
+
+	rn := rnLeafSwitchSelector(selector)
+	dn := dnLeafSwitchSelector(profile, selector)
+
+	j := fmt.Sprintf(`{"infraLeafS":{"attributes":{"dn":"uni/%s","name":"%s","descr":"%s","type":"range","rn":"%s","status":"created"}}}`,
+		dn, selector, descr, rn)
+
+	return c.moPost(me, dn, j)
+}
+
+// LeafSwitchSelectorDel deletes a leaf selector from a leaf switch profile.
+func (c *Client) LeafSwitchSelectorDel(profile, selector string) error {
+
+	me := "LeafSwitchSelectorDel"
+
+	dnP := dnLeafSwitchProfile(profile)
+	dn := dnLeafSwitchSelector(profile, selector)
+
+	j := fmt.Sprintf(`{"infraNodeP":{"attributes":{"dn":"uni/%s","status":"modified"},"children":[{"infraLeafS":{"attributes":{"dn":"uni/%s","status":"deleted"}}}]}}`,
+		dnP, dn)
+
+	return c.moPost(me, dnP, j)
+}
+
+// LeafSwitchSelectorList retrieves the list of leaf selectors from a leaf switch profile.
+func (c *Client) LeafSwitchSelectorList(profile string) ([]map[string]interface{}, error) {
+
+	me := "LeafSwitchSelectorList"
+
+	key := "infraLeafS"
+
+	dnP := dnLeafSwitchProfile(profile)
+
+	return c.moChildrenAttributes(me, dnP, key)
+}
+
+// LeafSwitchNodeBlockAdd creates a node block in a leaf selector.
+// from, to: node IDs. Example: from="101" to="102"
+func (c *Client) LeafSwitchNodeBlockAdd(profile, selector, block, from, to string) error {
+
+	me := "LeafSwitchNodeBlockAdd"
+
+	dnS := dnLeafSwitchSelector(profile, selector)
+	rn := rnNodeBlock(block)
+
+	j := fmt.Sprintf(`{"infraNodeBlk":{"attributes":{"dn":"uni/%s/%s","name":"%s","from_":"%s","to_":"%s","rn":"%s","status":"created,modified"}}}`,
+		dnS, rn, block, from, to, rn)
+
+	return c.moPost(me, dnS+"/"+rn, j)
+}
+
+// LeafSwitchNodeBlockDel deletes a node block from a leaf selector.
+func (c *Client) LeafSwitchNodeBlockDel(profile, selector, block string) error {
+
+	me := "LeafSwitchNodeBlockDel"
+
+	dnS := dnLeafSwitchSelector(profile, selector)
+	rn := rnNodeBlock(block)
+
+	j := fmt.Sprintf(`{"infraLeafS":{"attributes":{"dn":"uni/%s","status":"modified"},"children":[{"infraNodeBlk":{"attributes":{"dn":"uni/%s/%s","status":"deleted"}}}]}}`,
+		dnS, dnS, rn)
+
+	return c.moPost(me, dnS, j)
+}
+
+// LeafSwitchNodeBlockList retrieves the list of node blocks from a leaf selector.
+func (c *Client) LeafSwitchNodeBlockList(profile, selector string) ([]map[string]interface{}, error) {
+
+	me := "LeafSwitchNodeBlockList"
+
+	key := "infraNodeBlk"
+
+	dnS := dnLeafSwitchSelector(profile, selector)
+
+	return c.moChildrenAttributes(me, dnS, key)
+}
+
+// LeafSwitchInterfaceProfileAdd associates a leaf interface profile to a leaf switch profile.
+func (c *Client) LeafSwitchInterfaceProfileAdd(switchProfile, interfaceProfile string) error {
+
+	me := "LeafSwitchInterfaceProfileAdd"
+
+	dn := dnLeafSwitchProfile(switchProfile)
+	dnI := dnLeafInterfaceProfile(interfaceProfile)
+
+	j := fmt.Sprintf(`{"infraRsAccPortP":{"attributes":{"tDn":"uni/%s","status":"created,modified"}}}`,
+		dnI)
+
+	return c.moPost(me, dn, j)
+}
+
+// LeafSwitchInterfaceProfileDel dissociates a leaf interface profile from a leaf switch profile.
+func (c *Client) LeafSwitchInterfaceProfileDel(switchProfile, interfaceProfile string) error {
+
+	me := "LeafSwitchInterfaceProfileDel"
+
+	dn := dnLeafSwitchProfile(switchProfile)
+	dnI := dnLeafInterfaceProfile(interfaceProfile)
+
+	j := fmt.Sprintf(`{"infraNodeP":{"attributes":{"dn":"uni/%s","status":"modified"},"children":[{"infraRsAccPortP":{"attributes":{"dn":"uni/%s/rsaccPortP-[uni/%s]","status":"deleted"}}}]}}`,
+		dn, dn, dnI)
+
+	return c.moPost(me, dn, j)
+}
+
+// LeafSwitchInterfaceProfileList retrieves the list of leaf interface profiles associated to a leaf switch profile.
+func (c *Client) LeafSwitchInterfaceProfileList(switchProfile string) ([]map[string]interface{}, error) {
+
+	me := "LeafSwitchInterfaceProfileList"
+
+	key := "infraRsAccPortP"
+
+	dn := dnLeafSwitchProfile(switchProfile)
+
+	return c.moChildrenAttributes(me, dn, key)
+}
@@ -0,0 +1,72 @@
+package aci
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// moPost posts a JSON object to the managed object given by dn. Example: dn="tn-tenant1/out-out1"
+func (c *Client) moPost(me, dn, j string) error {
+
+	api := "/api/node/mo/uni/" + dn + ".json"
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s json=%s", me, url, j)
+
+	body, errPost := c.post(url, contentTypeJSON, bytes.NewBufferString(j))
+	if errPost != nil {
+		return fmt.Errorf("%s: %v", me, errPost)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return parseJSONError(body)
+}
+
+// moChildDel deletes the child object given by rn from the managed object given by parentDn.
+func (c *Client) moChildDel(me, parentClass, parentDn, class, rn string) error {
+
+	j := fmt.Sprintf(`{"%s":{"attributes":{"dn":"uni/%s","status":"modified"},"children":[{"%s":{"attributes":{"dn":"uni/%s/%s","status":"deleted"}}}]}}`,
+		parentClass, parentDn, class, parentDn, rn)
+
+	return c.moPost(me, parentDn, j)
+}
+
+// moChildren retrieves the children of given classes from the managed object given by dn.
+func (c *Client) moChildren(me, dn string, classes ...string) ([]imdataObject, error) {
+
+	api := "/api/node/mo/uni/" + dn + ".json?query-target=children&target-subtree-class=" + strings.Join(classes, ",")
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return jsonImdataObjects(c, body, me)
+}
+
+// moChildrenAttributes retrieves the attributes of the children of a given class from the managed object given by dn.
+func (c *Client) moChildrenAttributes(me, dn, key string) ([]map[string]interface{}, error) {
+
+	objs, errList := c.moChildren(me, dn, key)
+	if errList != nil {
+		return nil, errList
+	}
+
+	list := make([]map[string]interface{}, 0, len(objs))
+	for _, obj := range objs {
+		if obj.class == key {
+			list = append(list, obj.attr)
+		}
+	}
+
+	return list, nil
+}
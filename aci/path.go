@@ -0,0 +1,82 @@
+package aci
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Path kinds.
+const (
+	PathKindPort = "port" // Individual port. Example: "topology/pod-1/paths-101/pathep-[eth1/1]"
+	PathKindPC   = "pc"   // Port channel. Example: "topology/pod-1/paths-101/pathep-[pc-group]"
+	PathKindVPC  = "vpc"  // Virtual port channel. Example: "topology/pod-1/protpaths-101-102/pathep-[vpc-group]"
+)
+
+// Path describes a fabric path endpoint (fabricPathEp).
+type Path struct {
+	Dn        string   // Path DN. Example: "topology/pod-1/paths-101/pathep-[eth1/1]"
+	Kind      string   // PathKindPort, PathKindPC, PathKindVPC
+	Pod       string   // Example: "1"
+	Nodes     []string // Leaf nodes. One node for port and PC, two nodes for vPC. Example: []string{"101", "102"}
+	Interface string   // Port name (Example: "eth1/1") or PC/vPC policy group name.
+}
+
+// PathPort builds the path DN for an individual port. Example: pod="1" node="101" port="eth1/1"
+func PathPort(pod, node, port string) string {
+	return fmt.Sprintf("topology/pod-%s/paths-%s/pathep-[%s]", pod, node, port)
+}
+
+// PathPortChannel builds the path DN for a port channel. Example: pod="1" node="101" group="pc-group"
+// group is the PC interface policy group name.
+func PathPortChannel(pod, node, group string) string {
+	return fmt.Sprintf("topology/pod-%s/paths-%s/pathep-[%s]", pod, node, group)
+}
+
+// PathVPC builds the path DN for a virtual port channel. Example: pod="1" node1="101" node2="102" group="vpc-group"
+// group is the vPC interface policy group name.
+func PathVPC(pod, node1, node2, group string) string {
+	return fmt.Sprintf("topology/pod-%s/protpaths-%s-%s/pathep-[%s]", pod, node1, node2, group)
+}
+
+// PathParse parses a path DN.
+func PathParse(dn string) (Path, error) {
+
+	// "topology/pod-1/paths-101/pathep-[eth1/1]"
+	// "topology/pod-1/protpaths-101-102/pathep-[vpc-group]"
+	// "topology/pod-1/paths-101/extpaths-110/pathep-[eth1/1]"
+
+	p := Path{Dn: dn}
+
+	sep := strings.Index(dn, "/pathep-[")
+	if sep < 0 || !strings.HasSuffix(dn, "]") {
+		return p, fmt.Errorf("PathParse: missing pathep: %s", dn)
+	}
+
+	p.Interface = dn[sep+len("/pathep-[") : len(dn)-1]
+
+	for _, s := range strings.Split(dn[:sep], "/") {
+		switch {
+		case strings.HasPrefix(s, "pod-"):
+			p.Pod = stripPrefix(s, "pod-")
+		case strings.HasPrefix(s, "protpaths-"):
+			p.Nodes = strings.Split(stripPrefix(s, "protpaths-"), "-")
+			p.Kind = PathKindVPC
+		case strings.HasPrefix(s, "paths-"):
+			p.Nodes = []string{stripPrefix(s, "paths-")}
+		}
+	}
+
+	if p.Pod == "" || len(p.Nodes) < 1 {
+		return p, fmt.Errorf("PathParse: missing pod or node: %s", dn)
+	}
+
+	if p.Kind == "" {
+		if strings.HasPrefix(p.Interface, "eth") {
+			p.Kind = PathKindPort
+		} else {
+			p.Kind = PathKindPC
+		}
+	}
+
+	return p, nil
+}
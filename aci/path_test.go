@@ -0,0 +1,44 @@
+package aci
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPathParse(t *testing.T) {
+	pathParseTest(t, "topology/pod-1/paths-101/pathep-[eth1/1]", PathKindPort, "1", "101", "eth1/1")
+	pathParseTest(t, "topology/pod-1/paths-101/pathep-[pc-group]", PathKindPC, "1", "101", "pc-group")
+	pathParseTest(t, "topology/pod-2/protpaths-201-202/pathep-[vpc-group]", PathKindVPC, "2", "201,202", "vpc-group")
+	pathParseTest(t, "topology/pod-1/paths-101/extpaths-110/pathep-[eth1/10]", PathKindPort, "1", "101", "eth1/10")
+	pathParseTest(t, "topology/pod-1/paths-101/pathep-[eth1/1/2]", PathKindPort, "1", "101", "eth1/1/2")
+
+	for _, bad := range []string{"", "topology/pod-1/paths-101", "topology/pathep-[eth1/1]", "topology/pod-1/paths-101/pathep-[eth1/1"} {
+		if _, err := PathParse(bad); err == nil {
+			t.Errorf("input=%s expected error", bad)
+		}
+	}
+}
+
+func pathParseTest(t *testing.T, input, wantKind, wantPod, wantNodes, wantInterface string) {
+	p, err := PathParse(input)
+	if err != nil {
+		t.Errorf("input=%s error: %v", input, err)
+		return
+	}
+	nodes := strings.Join(p.Nodes, ",")
+	if p.Kind != wantKind || p.Pod != wantPod || nodes != wantNodes || p.Interface != wantInterface {
+		t.Errorf("input=%s want=%s/%s/%s/%s got=%s/%s/%s/%s", input, wantKind, wantPod, wantNodes, wantInterface, p.Kind, p.Pod, nodes, p.Interface)
+	}
+}
+
+func TestPathPort(t *testing.T) {
+	want := "topology/pod-1/paths-101/pathep-[eth1/1]"
+	if got := PathPort("1", "101", "eth1/1"); got != want {
+		t.Errorf("want=%s got=%s", want, got)
+	}
+}
+
+func TestPathBuild(t *testing.T) {
+	pathParseTest(t, PathPortChannel("1", "101", "pc-group"), PathKindPC, "1", "101", "pc-group")
+	pathParseTest(t, PathVPC("2", "201", "202", "vpc-group"), PathKindVPC, "2", "201,202", "vpc-group")
+}
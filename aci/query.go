@@ -0,0 +1,79 @@
+package aci
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// timeFormat is the timestamp format used by APIC. Example: "2017-05-12T10:04:33.123+00:00"
+const timeFormat = "2006-01-02T15:04:05.000-07:00"
+
+// queryEq: "faultInst", "code", "F0467" => `eq(faultInst.code,"F0467")`
+func queryEq(class, prop, value string) string {
+	return fmt.Sprintf(`eq(%s.%s,"%s")`, class, prop, value)
+}
+
+// queryWcard: "aaaModLR", "affected", "uni/tn-prod" => `wcard(aaaModLR.affected,"uni/tn-prod")`
+func queryWcard(class, prop, value string) string {
+	return fmt.Sprintf(`wcard(%s.%s,"%s")`, class, prop, value)
+}
+
+// queryGe: `ge(faultInst.created,"2017-05-12T10:04:33.000+00:00")`
+func queryGe(class, prop string, t time.Time) string {
+	return fmt.Sprintf(`ge(%s.%s,"%s")`, class, prop, t.Format(timeFormat))
+}
+
+// queryLe: `le(faultInst.created,"2017-05-12T10:04:33.000+00:00")`
+func queryLe(class, prop string, t time.Time) string {
+	return fmt.Sprintf(`le(%s.%s,"%s")`, class, prop, t.Format(timeFormat))
+}
+
+// queryAnd combines non-empty terms: "a", "b" => "and(a,b)"
+func queryAnd(terms ...string) string {
+	return queryOp("and", terms)
+}
+
+// queryOr combines non-empty terms: "a", "b" => "or(a,b)"
+func queryOr(terms ...string) string {
+	return queryOp("or", terms)
+}
+
+func queryOp(op string, terms []string) string {
+	var list []string
+	for _, t := range terms {
+		if t != "" {
+			list = append(list, t)
+		}
+	}
+	switch len(list) {
+	case 0:
+		return ""
+	case 1:
+		return list[0]
+	}
+	return op + "(" + strings.Join(list, ",") + ")"
+}
+
+// queryFilter builds the query-target-filter option, if filter is not empty.
+func queryFilter(filter string) string {
+	if filter == "" {
+		return ""
+	}
+	return "query-target-filter=" + url.QueryEscape(filter)
+}
+
+// queryOptions joins non-empty options: "a=1", "", "b=2" => "?a=1&b=2"
+func queryOptions(options ...string) string {
+	var list []string
+	for _, o := range options {
+		if o != "" {
+			list = append(list, o)
+		}
+	}
+	if len(list) < 1 {
+		return ""
+	}
+	return "?" + strings.Join(list, "&")
+}
@@ -0,0 +1,162 @@
+package aci
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Record actions, as reported by Record.Ind.
+const (
+	RecordActionCreation     = "creation"
+	RecordActionModification = "modification"
+	RecordActionDeletion     = "deletion"
+)
+
+// Record holds a history record: fault record (faultRecord), event record (eventRecord) or audit log record (aaaModLR).
+type Record struct {
+	Dn        string
+	ID        string
+	Affected  string // DN of the affected object. Example: "uni/tn-prod/BD-web"
+	Code      string
+	Severity  string
+	Cause     string
+	Descr     string
+	Ind       string // Action: RecordActionCreation, RecordActionModification, RecordActionDeletion, ...
+	Created   string
+	User      string // User who caused the record. Not reported for fault records.
+	ChangeSet string // Changed properties. Reported for audit log records only.
+	Lc        string // Lifecycle. Reported for fault records only.
+}
+
+func recordFromAttributes(attr map[string]interface{}) Record {
+	return Record{
+		Dn:        mapString(attr, "dn"),
+		ID:        mapString(attr, "id"),
+		Affected:  mapString(attr, "affected"),
+		Code:      mapString(attr, "code"),
+		Severity:  mapString(attr, "severity"),
+		Cause:     mapString(attr, "cause"),
+		Descr:     mapString(attr, "descr"),
+		Ind:       mapString(attr, "ind"),
+		Created:   mapString(attr, "created"),
+		User:      mapString(attr, "user"),
+		ChangeSet: mapString(attr, "changeSet"),
+		Lc:        mapString(attr, "lc"),
+	}
+}
+
+// RecordFilter selects records for FaultRecordQuery(), EventRecordQuery() and AuditLogQuery(). Empty fields do not restrict the query.
+type RecordFilter struct {
+	Since    time.Time // Only records created at or after this time.
+	Until    time.Time // Only records created at or before this time.
+	User     string    // Only records caused by this user. Not supported for fault records: FaultRecordQuery() returns an error.
+	Affected string    // Only records whose affected DN contains this string. Example: "uni/tn-prod/BD-web"
+	Action   string    // Only records for this action: RecordActionCreation, RecordActionModification, RecordActionDeletion
+	Code     string    // Only records with this code. Example: "E4204936"
+	Page     int       // Page number, starting from 0.
+	PageSize int       // Records per page. Zero means no pagination.
+}
+
+// recordQueryFilter builds the query-target-filter expression for a RecordFilter.
+func recordQueryFilter(key string, f RecordFilter) string {
+
+	var terms []string
+	if !f.Since.IsZero() {
+		terms = append(terms, queryGe(key, "created", f.Since))
+	}
+	if !f.Until.IsZero() {
+		terms = append(terms, queryLe(key, "created", f.Until))
+	}
+	if f.User != "" {
+		terms = append(terms, queryEq(key, "user", f.User))
+	}
+	if f.Affected != "" {
+		terms = append(terms, queryWcard(key, "affected", f.Affected))
+	}
+	if f.Action != "" {
+		terms = append(terms, queryEq(key, "ind", f.Action))
+	}
+	if f.Code != "" {
+		terms = append(terms, queryEq(key, "code", f.Code))
+	}
+
+	return queryAnd(terms...)
+}
+
+// recordQueryOptions builds the query options for a RecordFilter, most recent records first.
+func recordQueryOptions(key string, f RecordFilter) string {
+
+	var page, pageSize string
+	if f.PageSize > 0 {
+		page = "page=" + strconv.Itoa(f.Page)
+		pageSize = "page-size=" + strconv.Itoa(f.PageSize)
+	}
+
+	return queryOptions(queryFilter(recordQueryFilter(key, f)), "order-by="+key+".created|desc", page, pageSize)
+}
+
+// recordFilterCheck rejects filters not supported by the record class.
+func recordFilterCheck(key string, f RecordFilter) error {
+	if f.User != "" && key == "faultRecord" {
+		return fmt.Errorf("user filter not supported for %s", key)
+	}
+	if f.Page < 0 || f.PageSize < 0 {
+		return fmt.Errorf("bad pagination: page=%d page-size=%d", f.Page, f.PageSize)
+	}
+	return nil
+}
+
+func (c *Client) recordQuery(me, key string, f RecordFilter) ([]Record, error) {
+
+	if errFilter := recordFilterCheck(key, f); errFilter != nil {
+		return nil, fmt.Errorf("%s: %v", me, errFilter)
+	}
+
+	api := "/api/node/class/" + key + ".json" + recordQueryOptions(key, f)
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	attrs, errAttr := jsonImdataAttributes(c, body, key, me)
+	if errAttr != nil {
+		return nil, fmt.Errorf("%s: %v", me, errAttr)
+	}
+
+	records := make([]Record, 0, len(attrs))
+	for _, attr := range attrs {
+		records = append(records, recordFromAttributes(attr))
+	}
+
+	return records, nil
+}
+
+// FaultRecordQuery retrieves the fault history records (faultRecord) selected by the filter, most recent first.
+func (c *Client) FaultRecordQuery(f RecordFilter) ([]Record, error) {
+	return c.recordQuery("FaultRecordQuery", "faultRecord", f)
+}
+
+// EventRecordQuery retrieves the event records (eventRecord) selected by the filter, most recent first.
+func (c *Client) EventRecordQuery(f RecordFilter) ([]Record, error) {
+	return c.recordQuery("EventRecordQuery", "eventRecord", f)
+}
+
+// AuditLogQuery retrieves the audit log records (aaaModLR) selected by the filter, most recent first.
+// Example - who deleted BD web in tenant prod since yesterday:
+//
+//	records, err := c.AuditLogQuery(aci.RecordFilter{
+//	        Affected: "uni/tn-prod/BD-web",
+//	        Action:   aci.RecordActionDeletion,
+//	        Since:    time.Now().Add(-24 * time.Hour),
+//	})
+func (c *Client) AuditLogQuery(f RecordFilter) ([]Record, error) {
+	return c.recordQuery("AuditLogQuery", "aaaModLR", f)
+}
@@ -0,0 +1,68 @@
+package aci
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRecordQueryFilter(t *testing.T) {
+	since := time.Date(2017, 5, 12, 10, 4, 33, 0, time.UTC)
+	until := time.Date(2017, 5, 13, 10, 4, 33, 0, time.UTC)
+
+	for _, key := range []string{"faultRecord", "eventRecord", "aaaModLR"} {
+		recordQueryFilterTest(t, key, RecordFilter{}, ``)
+		recordQueryFilterTest(t, key, RecordFilter{Since: since},
+			`ge(`+key+`.created,"2017-05-12T10:04:33.000+00:00")`)
+		recordQueryFilterTest(t, key, RecordFilter{Since: since, Until: until},
+			`and(ge(`+key+`.created,"2017-05-12T10:04:33.000+00:00"),le(`+key+`.created,"2017-05-13T10:04:33.000+00:00"))`)
+		recordQueryFilterTest(t, key, RecordFilter{Affected: "uni/tn-prod/BD-web"},
+			`wcard(`+key+`.affected,"uni/tn-prod/BD-web")`)
+		recordQueryFilterTest(t, key, RecordFilter{Action: RecordActionDeletion},
+			`eq(`+key+`.ind,"deletion")`)
+		recordQueryFilterTest(t, key, RecordFilter{Affected: "uni/tn-prod", Action: RecordActionCreation, Code: "E4204936"},
+			`and(wcard(`+key+`.affected,"uni/tn-prod"),eq(`+key+`.ind,"creation"),eq(`+key+`.code,"E4204936"))`)
+	}
+
+	recordQueryFilterTest(t, "eventRecord", RecordFilter{User: "admin"}, `eq(eventRecord.user,"admin")`)
+	recordQueryFilterTest(t, "aaaModLR", RecordFilter{User: "admin", Action: RecordActionDeletion},
+		`and(eq(aaaModLR.user,"admin"),eq(aaaModLR.ind,"deletion"))`)
+}
+
+func recordQueryFilterTest(t *testing.T, key string, f RecordFilter, want string) {
+	if got := recordQueryFilter(key, f); got != want {
+		t.Errorf("key=%s filter=%v want=%s got=%s", key, f, want, got)
+	}
+}
+
+func TestRecordQueryOptions(t *testing.T) {
+	for _, key := range []string{"faultRecord", "eventRecord", "aaaModLR"} {
+		order := "?order-by=" + key + ".created|desc"
+		recordQueryOptionsTest(t, key, RecordFilter{}, order)
+		recordQueryOptionsTest(t, key, RecordFilter{Page: 3}, order)
+		recordQueryOptionsTest(t, key, RecordFilter{PageSize: 50}, order+"&page=0&page-size=50")
+		recordQueryOptionsTest(t, key, RecordFilter{Page: 2, PageSize: 50}, order+"&page=2&page-size=50")
+		recordQueryOptionsTest(t, key, RecordFilter{Action: RecordActionCreation, Page: 1, PageSize: 10},
+			"?query-target-filter=eq%28"+key+".ind%2C%22creation%22%29&order-by="+key+".created|desc&page=1&page-size=10")
+	}
+}
+
+func recordQueryOptionsTest(t *testing.T, key string, f RecordFilter, want string) {
+	if got := recordQueryOptions(key, f); got != want {
+		t.Errorf("key=%s filter=%v want=%s got=%s", key, f, want, got)
+	}
+}
+
+func TestRecordFilterCheck(t *testing.T) {
+	recordFilterCheckTest(t, "faultRecord", RecordFilter{}, false)
+	recordFilterCheckTest(t, "faultRecord", RecordFilter{User: "admin"}, true)
+	recordFilterCheckTest(t, "eventRecord", RecordFilter{User: "admin"}, false)
+	recordFilterCheckTest(t, "aaaModLR", RecordFilter{User: "admin"}, false)
+	recordFilterCheckTest(t, "aaaModLR", RecordFilter{Page: -1, PageSize: 10}, true)
+	recordFilterCheckTest(t, "aaaModLR", RecordFilter{PageSize: -1}, true)
+}
+
+func recordFilterCheckTest(t *testing.T, key string, f RecordFilter, wantErr bool) {
+	if err := recordFilterCheck(key, f); (err != nil) != wantErr {
+		t.Errorf("key=%s filter=%v wantErr=%v err=%v", key, f, wantErr, err)
+	}
+}
@@ -0,0 +1,360 @@
+package aci
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Route control directions.
+const (
+	RouteControlImport = "import"
+	RouteControlExport = "export"
+)
+
+// Route control context actions.
+const (
+	RouteControlPermit = "permit"
+	RouteControlDeny   = "deny"
+)
+
+// Names of the L3Out route control profiles applied by default to all routes imported/exported by the L3Out.
+const (
+	RouteProfileDefaultImport = "default-import"
+	RouteProfileDefaultExport = "default-export"
+)
+
+// RouteSetRule holds a route control set rule (rtctrlAttrP).
+// Empty fields mean the corresponding action is not set.
+type RouteSetRule struct {
+	Name             string
+	Community        string // Example: "regular:as2-nn2:65001:100"
+	CommunityReplace bool   // Replace existing communities, instead of appending.
+	LocalPref        string // Example: "200"
+	Weight           string // Example: "100"
+	Descr            string
+}
+
+// RouteProfileContext holds a context of a route control profile (rtctrlCtxP).
+type RouteProfileContext struct {
+	Name       string
+	Order      string   // Evaluation order. Example: "0"
+	Action     string   // RouteControlPermit, RouteControlDeny
+	MatchRules []string // Match rule names.
+	SetRule    string   // Set rule name. Empty means no set rule.
+}
+
+func rnRouteMatchRule(rule string) string {
+	return "subj-" + rule
+}
+
+func dnRouteMatchRule(tenant, rule string) string {
+	return rnTenant(tenant) + "/" + rnRouteMatchRule(rule)
+}
+
+func rnRouteMatchPrefix(prefix string) string {
+	return "dest-[" + prefix + "]"
+}
+
+func rnRouteSetRule(rule string) string {
+	return "attr-" + rule
+}
+
+func rnRouteProfile(profile string) string {
+	return "prof-" + profile
+}
+
+func rnRouteProfileContext(ctx string) string {
+	return "ctx-" + ctx
+}
+
+// RouteMatchRuleAdd creates a route control match rule in a tenant.
+func (c *Client) RouteMatchRuleAdd(tenant, rule, descr string) error {
+	return c.tenantPolicyAdd("RouteMatchRuleAdd", tenant, "rtctrlSubjP", rnRouteMatchRule(rule), rule, descr, "")
+}
+
+// RouteMatchRuleDel deletes a route control match rule from a tenant.
+func (c *Client) RouteMatchRuleDel(tenant, rule string) error {
+	return c.moChildDel("RouteMatchRuleDel", "fvTenant", rnTenant(tenant), "rtctrlSubjP", rnRouteMatchRule(rule))
+}
+
+// RouteMatchRuleList retrieves the list of route control match rules in a tenant.
+func (c *Client) RouteMatchRuleList(tenant string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("RouteMatchRuleList", rnTenant(tenant), "rtctrlSubjP")
+}
+
+// RouteMatchRulePrefixAdd adds a prefix to a route control match rule. Example: prefix="10.0.0.0/8"
+// With aggregate, more specific prefixes are matched, with length from fromLen to toLen. Example: fromLen="16" toLen="24"
+func (c *Client) RouteMatchRulePrefixAdd(tenant, rule, prefix string, aggregate bool, fromLen, toLen string) error {
+	attrs := optionalAttr("fromPfxLen", fromLen) + optionalAttr("toPfxLen", toLen)
+	j := fmt.Sprintf(`{"rtctrlMatchRtDest":{"attributes":{"ip":"%s","aggregate":"%s"%s,"status":"created,modified"}}}`,
+		prefix, yesNo(aggregate), attrs)
+	return c.moPost("RouteMatchRulePrefixAdd", dnRouteMatchRule(tenant, rule), j)
+}
+
+// RouteMatchRulePrefixDel deletes a prefix from a route control match rule.
+func (c *Client) RouteMatchRulePrefixDel(tenant, rule, prefix string) error {
+	return c.moChildDel("RouteMatchRulePrefixDel", "rtctrlSubjP", dnRouteMatchRule(tenant, rule), "rtctrlMatchRtDest", rnRouteMatchPrefix(prefix))
+}
+
+// RouteMatchRulePrefixList retrieves the list of prefixes in a route control match rule.
+func (c *Client) RouteMatchRulePrefixList(tenant, rule string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("RouteMatchRulePrefixList", dnRouteMatchRule(tenant, rule), "rtctrlMatchRtDest")
+}
+
+func routeSetRuleJSON(dn string, r RouteSetRule) string {
+
+	var children []string
+
+	if r.Community != "" {
+		criteria := "append"
+		if r.CommunityReplace {
+			criteria = "replace"
+		}
+		children = append(children, fmt.Sprintf(`{"rtctrlSetComm":{"attributes":{"community":"%s","setCriteria":"%s","status":"created,modified"}}}`, r.Community, criteria))
+	}
+	if r.LocalPref != "" {
+		children = append(children, fmt.Sprintf(`{"rtctrlSetPref":{"attributes":{"localPref":"%s","status":"created,modified"}}}`, r.LocalPref))
+	}
+	if r.Weight != "" {
+		children = append(children, fmt.Sprintf(`{"rtctrlSetWeight":{"attributes":{"weight":"%s","status":"created,modified"}}}`, r.Weight))
+	}
+
+	return fmt.Sprintf(`{"rtctrlAttrP":{"attributes":{"dn":"uni/%s","name":"%s","descr":"%s","rn":"%s","status":"created,modified"},"children":[%s]}}`,
+		dn, r.Name, r.Descr, rnRouteSetRule(r.Name), strings.Join(children, ","))
+}
+
+// RouteSetRuleAdd creates a route control set rule in a tenant.
+func (c *Client) RouteSetRuleAdd(tenant string, rule RouteSetRule) error {
+	dn := rnTenant(tenant) + "/" + rnRouteSetRule(rule.Name)
+	return c.moPost("RouteSetRuleAdd", dn, routeSetRuleJSON(dn, rule))
+}
+
+// RouteSetRuleDel deletes a route control set rule from a tenant.
+func (c *Client) RouteSetRuleDel(tenant, rule string) error {
+	return c.moChildDel("RouteSetRuleDel", "fvTenant", rnTenant(tenant), "rtctrlAttrP", rnRouteSetRule(rule))
+}
+
+// RouteSetRuleList retrieves the list of route control set rules in a tenant.
+func (c *Client) RouteSetRuleList(tenant string) ([]RouteSetRule, error) {
+
+	me := "RouteSetRuleList"
+
+	key := "rtctrlAttrP"
+
+	api := "/api/node/mo/uni/" + rnTenant(tenant) + ".json?query-target=children&target-subtree-class=" + key + "&rsp-subtree=children&rsp-subtree-class=rtctrlSetComm,rtctrlSetPref,rtctrlSetWeight"
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	objs, errObj := jsonImdataObjects(c, body, me)
+	if errObj != nil {
+		return nil, errObj
+	}
+
+	var list []RouteSetRule
+	for _, obj := range objs {
+		if obj.class != key {
+			continue
+		}
+		r := RouteSetRule{
+			Name:  mapString(obj.attr, "name"),
+			Descr: mapString(obj.attr, "descr"),
+		}
+		for _, s := range obj.childrenByClass("rtctrlSetComm") {
+			r.Community = mapString(s.attr, "community")
+			r.CommunityReplace = mapString(s.attr, "setCriteria") == "replace"
+		}
+		for _, s := range obj.childrenByClass("rtctrlSetPref") {
+			r.LocalPref = mapString(s.attr, "localPref")
+		}
+		for _, s := range obj.childrenByClass("rtctrlSetWeight") {
+			r.Weight = mapString(s.attr, "weight")
+		}
+		list = append(list, r)
+	}
+
+	return list, nil
+}
+
+// routeProfileAdd creates a route control profile under the managed object given by parentDn.
+func (c *Client) routeProfileAdd(me, parentDn, profile, descr string) error {
+
+	rn := rnRouteProfile(profile)
+
+	dn := parentDn + "/" + rn
+
+	j := fmt.Sprintf(`{"rtctrlProfile":{"attributes":{"dn":"uni/%s","name":"%s","descr":"%s","rn":"%s","status":"created"}}}`,
+		dn, profile, descr, rn)
+
+	return c.moPost(me, dn, j)
+}
+
+func routeProfileContextJSON(ctx RouteProfileContext) string {
+
+	var children []string
+
+	for _, m := range ctx.MatchRules {
+		children = append(children, fmt.Sprintf(`{"rtctrlRsCtxPToSubjP":{"attributes":{"tnRtctrlSubjPName":"%s","status":"created,modified"}}}`, m))
+	}
+
+	if ctx.SetRule != "" {
+		children = append(children, fmt.Sprintf(`{"rtctrlScope":{"attributes":{"status":"created,modified"},"children":[{"rtctrlRsScopeToAttrP":{"attributes":{"tnRtctrlAttrPName":"%s","status":"created,modified"}}}]}}`, ctx.SetRule))
+	}
+
+	attrs := optionalAttr("order", ctx.Order) + optionalAttr("action", ctx.Action)
+
+	return fmt.Sprintf(`{"rtctrlCtxP":{"attributes":{"name":"%s"%s,"rn":"%s","status":"created,modified"},"children":[%s]}}`,
+		ctx.Name, attrs, rnRouteProfileContext(ctx.Name), strings.Join(children, ","))
+}
+
+// routeProfileContextList retrieves the contexts of the route control profile given by profileDn.
+func (c *Client) routeProfileContextList(me, profileDn string) ([]RouteProfileContext, error) {
+
+	key := "rtctrlCtxP"
+
+	api := "/api/node/mo/uni/" + profileDn + ".json?query-target=children&target-subtree-class=" + key + "&rsp-subtree=full&rsp-subtree-class=rtctrlRsCtxPToSubjP,rtctrlScope,rtctrlRsScopeToAttrP"
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	objs, errObj := jsonImdataObjects(c, body, me)
+	if errObj != nil {
+		return nil, errObj
+	}
+
+	var list []RouteProfileContext
+	for _, obj := range objs {
+		if obj.class != key {
+			continue
+		}
+		ctx := RouteProfileContext{
+			Name:   mapString(obj.attr, "name"),
+			Order:  mapString(obj.attr, "order"),
+			Action: mapString(obj.attr, "action"),
+		}
+		for _, m := range obj.childrenByClass("rtctrlRsCtxPToSubjP") {
+			ctx.MatchRules = append(ctx.MatchRules, mapString(m.attr, "tnRtctrlSubjPName"))
+		}
+		for _, scope := range obj.childrenByClass("rtctrlScope") {
+			for _, s := range scope.childrenByClass("rtctrlRsScopeToAttrP") {
+				ctx.SetRule = mapString(s.attr, "tnRtctrlAttrPName")
+			}
+		}
+		list = append(list, ctx)
+	}
+
+	return list, nil
+}
+
+// RouteProfileAdd creates a route control profile (route map) in a tenant.
+func (c *Client) RouteProfileAdd(tenant, profile, descr string) error {
+	return c.routeProfileAdd("RouteProfileAdd", rnTenant(tenant), profile, descr)
+}
+
+// RouteProfileDel deletes a route control profile from a tenant.
+func (c *Client) RouteProfileDel(tenant, profile string) error {
+	return c.moChildDel("RouteProfileDel", "fvTenant", rnTenant(tenant), "rtctrlProfile", rnRouteProfile(profile))
+}
+
+// RouteProfileList retrieves the list of route control profiles in a tenant.
+func (c *Client) RouteProfileList(tenant string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("RouteProfileList", rnTenant(tenant), "rtctrlProfile")
+}
+
+// RouteProfileContextAdd creates a context in a tenant route control profile.
+func (c *Client) RouteProfileContextAdd(tenant, profile string, ctx RouteProfileContext) error {
+	return c.moPost("RouteProfileContextAdd", rnTenant(tenant)+"/"+rnRouteProfile(profile), routeProfileContextJSON(ctx))
+}
+
+// RouteProfileContextDel deletes a context from a tenant route control profile.
+func (c *Client) RouteProfileContextDel(tenant, profile, ctx string) error {
+	return c.moChildDel("RouteProfileContextDel", "rtctrlProfile", rnTenant(tenant)+"/"+rnRouteProfile(profile), "rtctrlCtxP", rnRouteProfileContext(ctx))
+}
+
+// RouteProfileContextList retrieves the list of contexts in a tenant route control profile.
+func (c *Client) RouteProfileContextList(tenant, profile string) ([]RouteProfileContext, error) {
+	return c.routeProfileContextList("RouteProfileContextList", rnTenant(tenant)+"/"+rnRouteProfile(profile))
+}
+
+// L3ExtOutRouteProfileAdd creates a route control profile in an external routed network.
+// Use RouteProfileDefaultImport or RouteProfileDefaultExport to control all routes imported/exported by the L3Out.
+func (c *Client) L3ExtOutRouteProfileAdd(tenant, out, profile, descr string) error {
+	return c.routeProfileAdd("L3ExtOutRouteProfileAdd", dnL3ExtOut(tenant, out), profile, descr)
+}
+
+// L3ExtOutRouteProfileDel deletes a route control profile from an external routed network.
+func (c *Client) L3ExtOutRouteProfileDel(tenant, out, profile string) error {
+	return c.moChildDel("L3ExtOutRouteProfileDel", "l3extOut", dnL3ExtOut(tenant, out), "rtctrlProfile", rnRouteProfile(profile))
+}
+
+// L3ExtOutRouteProfileList retrieves the list of route control profiles in an external routed network.
+func (c *Client) L3ExtOutRouteProfileList(tenant, out string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("L3ExtOutRouteProfileList", dnL3ExtOut(tenant, out), "rtctrlProfile")
+}
+
+// L3ExtOutRouteProfileContextAdd creates a context in a route control profile of an external routed network.
+func (c *Client) L3ExtOutRouteProfileContextAdd(tenant, out, profile string, ctx RouteProfileContext) error {
+	return c.moPost("L3ExtOutRouteProfileContextAdd", dnL3ExtOut(tenant, out)+"/"+rnRouteProfile(profile), routeProfileContextJSON(ctx))
+}
+
+// L3ExtOutRouteProfileContextDel deletes a context from a route control profile of an external routed network.
+func (c *Client) L3ExtOutRouteProfileContextDel(tenant, out, profile, ctx string) error {
+	return c.moChildDel("L3ExtOutRouteProfileContextDel", "rtctrlProfile", dnL3ExtOut(tenant, out)+"/"+rnRouteProfile(profile), "rtctrlCtxP", rnRouteProfileContext(ctx))
+}
+
+// L3ExtOutRouteProfileContextList retrieves the list of contexts in a route control profile of an external routed network.
+func (c *Client) L3ExtOutRouteProfileContextList(tenant, out, profile string) ([]RouteProfileContext, error) {
+	return c.routeProfileContextList("L3ExtOutRouteProfileContextList", dnL3ExtOut(tenant, out)+"/"+rnRouteProfile(profile))
+}
+
+// L3ExtOutRouteControlEnforceSet enables/disables import route control enforcement on an external routed network.
+// Export route control is always enforced.
+func (c *Client) L3ExtOutRouteControlEnforceSet(tenant, out string, enforceImport bool) error {
+
+	dn := dnL3ExtOut(tenant, out)
+
+	enforce := RouteControlExport
+	if enforceImport {
+		enforce += "," + RouteControlImport
+	}
+
+	j := fmt.Sprintf(`{"l3extOut":{"attributes":{"dn":"uni/%s","enforceRtctrl":"%s","status":"modified"}}}`,
+		dn, enforce)
+
+	return c.moPost("L3ExtOutRouteControlEnforceSet", dn, j)
+}
+
+// L3ExtOutEPGRouteProfileAdd applies a tenant route control profile to an external EPG, in a direction.
+// direction: RouteControlImport, RouteControlExport
+func (c *Client) L3ExtOutEPGRouteProfileAdd(tenant, out, epg, profile, direction string) error {
+	j := fmt.Sprintf(`{"l3extRsInstPToProfile":{"attributes":{"tnRtctrlProfileName":"%s","direction":"%s","status":"created,modified"}}}`,
+		profile, direction)
+	return c.moPost("L3ExtOutEPGRouteProfileAdd", dnL3ExtEPG(tenant, out, epg), j)
+}
+
+// L3ExtOutEPGRouteProfileDel removes a route control profile from an external EPG.
+func (c *Client) L3ExtOutEPGRouteProfileDel(tenant, out, epg, profile, direction string) error {
+	return c.moChildDel("L3ExtOutEPGRouteProfileDel", "l3extInstP", dnL3ExtEPG(tenant, out, epg), "l3extRsInstPToProfile", "rsinstPToProfile-["+profile+"]-"+direction)
+}
+
+// L3ExtOutEPGRouteProfileList retrieves the list of route control profiles applied to an external EPG.
+// The attribute tnRtctrlProfileName holds the profile name; direction holds the direction.
+func (c *Client) L3ExtOutEPGRouteProfileList(tenant, out, epg string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("L3ExtOutEPGRouteProfileList", dnL3ExtEPG(tenant, out, epg), "l3extRsInstPToProfile")
+}
@@ -0,0 +1,30 @@
+package aci
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRouteSetRuleJSON(t *testing.T) {
+	j := routeSetRuleJSON("tn-t1/attr-r1", RouteSetRule{Name: "r1", Community: "regular:as2-nn2:65001:100", LocalPref: "200"})
+	if !json.Valid([]byte(j)) {
+		t.Errorf("invalid json: %s", j)
+	}
+	if !strings.Contains(j, `"setCriteria":"append"`) || strings.Contains(j, "rtctrlSetWeight") {
+		t.Errorf("unexpected json: %s", j)
+	}
+}
+
+func TestRouteProfileContextJSON(t *testing.T) {
+	j := routeProfileContextJSON(RouteProfileContext{Name: "c1", Order: "0", Action: RouteControlPermit, MatchRules: []string{"m1", "m2"}, SetRule: "r1"})
+	if !json.Valid([]byte(j)) {
+		t.Errorf("invalid json: %s", j)
+	}
+	if got := strings.Count(j, `"rtctrlRsCtxPToSubjP"`); got != 2 {
+		t.Errorf("json=%s want match rules=2 got=%d", j, got)
+	}
+	if !strings.Contains(j, `"tnRtctrlAttrPName":"r1"`) {
+		t.Errorf("json=%s missing set rule", j)
+	}
+}
@@ -0,0 +1,121 @@
+package aci
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OSPF network types for OSPFInterfacePolicyAdd.
+const (
+	OSPFNetworkBroadcast   = "bcast"
+	OSPFNetworkP2P         = "p2p"
+	OSPFNetworkUnspecified = "unspecified"
+)
+
+// OSPF interface control flags for OSPFInterfacePolicyAdd.
+const (
+	OSPFCtrlAdvertiseSubnet = "advert-subnet"
+	OSPFCtrlBFD             = "bfd"
+	OSPFCtrlMTUIgnore       = "mtu-ignore"
+	OSPFCtrlPassive         = "passive"
+)
+
+// BGP address families for VrfBGPAddressFamilySet.
+const (
+	BGPAddressFamilyIPv4 = "ipv4-ucast"
+	BGPAddressFamilyIPv6 = "ipv6-ucast"
+)
+
+// tenantPolicyAdd creates a policy directly under a tenant.
+// attrs holds extra attributes already formatted as `,"name":"value"` pairs.
+func (c *Client) tenantPolicyAdd(me, tenant, class, rn, name, descr, attrs string) error {
+
+	dn := rnTenant(tenant) + "/" + rn
+
+	j := fmt.Sprintf(`{"%s":{"attributes":{"dn":"uni/%s","name":"%s","descr":"%s"%s,"rn":"%s","status":"created"}}}`,
+		class, dn, name, descr, attrs, rn)
+
+	return c.moPost(me, dn, j)
+}
+
+// OSPFInterfacePolicyAdd creates an OSPF interface policy in a tenant.
+// networkType: OSPFNetworkBroadcast, OSPFNetworkP2P, OSPFNetworkUnspecified, "" (empty means default)
+// cost, helloInterval, deadInterval: "" (empty means default)
+// ctrl: list of OSPFCtrl* flags.
+func (c *Client) OSPFInterfacePolicyAdd(tenant, policy, networkType, cost, helloInterval, deadInterval string, ctrl []string, descr string) error {
+	attrs := optionalAttr("nwT", networkType) +
+		optionalAttr("cost", cost) +
+		optionalAttr("helloIntvl", helloInterval) +
+		optionalAttr("deadIntvl", deadInterval) +
+		optionalAttr("ctrl", strings.Join(ctrl, ","))
+	return c.tenantPolicyAdd("OSPFInterfacePolicyAdd", tenant, "ospfIfPol", "ospfIfPol-"+policy, policy, descr, attrs)
+}
+
+// OSPFInterfacePolicyDel deletes an OSPF interface policy from a tenant.
+func (c *Client) OSPFInterfacePolicyDel(tenant, policy string) error {
+	return c.moChildDel("OSPFInterfacePolicyDel", "fvTenant", rnTenant(tenant), "ospfIfPol", "ospfIfPol-"+policy)
+}
+
+// OSPFInterfacePolicyList retrieves the list of OSPF interface policies in a tenant.
+func (c *Client) OSPFInterfacePolicyList(tenant string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("OSPFInterfacePolicyList", rnTenant(tenant), "ospfIfPol")
+}
+
+// BGPTimersPolicyAdd creates a BGP timers policy in a tenant.
+// keepalive, hold and stale are intervals in seconds. "" (empty means default)
+func (c *Client) BGPTimersPolicyAdd(tenant, policy, keepalive, hold, stale, descr string) error {
+	attrs := optionalAttr("kaIntvl", keepalive) +
+		optionalAttr("holdIntvl", hold) +
+		optionalAttr("staleIntvl", stale)
+	return c.tenantPolicyAdd("BGPTimersPolicyAdd", tenant, "bgpCtxPol", "bgpCtxP-"+policy, policy, descr, attrs)
+}
+
+// BGPTimersPolicyDel deletes a BGP timers policy from a tenant.
+func (c *Client) BGPTimersPolicyDel(tenant, policy string) error {
+	return c.moChildDel("BGPTimersPolicyDel", "fvTenant", rnTenant(tenant), "bgpCtxPol", "bgpCtxP-"+policy)
+}
+
+// BGPTimersPolicyList retrieves the list of BGP timers policies in a tenant.
+func (c *Client) BGPTimersPolicyList(tenant string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("BGPTimersPolicyList", rnTenant(tenant), "bgpCtxPol")
+}
+
+// BGPAddressFamilyPolicyAdd creates a BGP address family context policy in a tenant.
+// ebgpDistance, ibgpDistance, localDistance: administrative distances. "" (empty means default)
+// maxEcmp, maxEcmpIbgp: maximum number of eBGP/iBGP ECMP paths. "" (empty means default)
+func (c *Client) BGPAddressFamilyPolicyAdd(tenant, policy, ebgpDistance, ibgpDistance, localDistance, maxEcmp, maxEcmpIbgp, descr string) error {
+	attrs := optionalAttr("eDist", ebgpDistance) +
+		optionalAttr("iDist", ibgpDistance) +
+		optionalAttr("localDist", localDistance) +
+		optionalAttr("maxEcmp", maxEcmp) +
+		optionalAttr("maxEcmpIbgp", maxEcmpIbgp)
+	return c.tenantPolicyAdd("BGPAddressFamilyPolicyAdd", tenant, "bgpCtxAfPol", "bgpCtxAfP-"+policy, policy, descr, attrs)
+}
+
+// BGPAddressFamilyPolicyDel deletes a BGP address family context policy from a tenant.
+func (c *Client) BGPAddressFamilyPolicyDel(tenant, policy string) error {
+	return c.moChildDel("BGPAddressFamilyPolicyDel", "fvTenant", rnTenant(tenant), "bgpCtxAfPol", "bgpCtxAfP-"+policy)
+}
+
+// BGPAddressFamilyPolicyList retrieves the list of BGP address family context policies in a tenant.
+func (c *Client) BGPAddressFamilyPolicyList(tenant string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("BGPAddressFamilyPolicyList", rnTenant(tenant), "bgpCtxAfPol")
+}
+
+// VrfBGPTimersSet attaches a BGP timers policy to a VRF.
+func (c *Client) VrfBGPTimersSet(tenant, vrf, policy string) error {
+	j := fmt.Sprintf(`{"fvRsBgpCtxPol":{"attributes":{"tnBgpCtxPolName":"%s","status":"created,modified"}}}`, policy)
+	return c.moPost("VrfBGPTimersSet", dnVrf(tenant, vrf), j)
+}
+
+// VrfBGPAddressFamilySet attaches a BGP address family context policy to a VRF, for an address family.
+// af: BGPAddressFamilyIPv4, BGPAddressFamilyIPv6
+func (c *Client) VrfBGPAddressFamilySet(tenant, vrf, af, policy string) error {
+	j := fmt.Sprintf(`{"fvRsCtxToBgpCtxAfPol":{"attributes":{"tnBgpCtxAfPolName":"%s","af":"%s","status":"created,modified"}}}`, policy, af)
+	return c.moPost("VrfBGPAddressFamilySet", dnVrf(tenant, vrf), j)
+}
+
+// VrfBGPAddressFamilyDel detaches a BGP address family context policy from a VRF.
+func (c *Client) VrfBGPAddressFamilyDel(tenant, vrf, af, policy string) error {
+	return c.moChildDel("VrfBGPAddressFamilyDel", "fvCtx", dnVrf(tenant, vrf), "fvRsCtxToBgpCtxAfPol", "rsctxToBgpCtxAfPol-["+policy+"]-"+af)
+}
@@ -0,0 +1,312 @@
+package aci
+
+import (
+	"bytes"
+	"fmt"
+)
+
+func rnScheduler(scheduler string) string {
+	return "schedp-" + scheduler
+}
+
+func dnScheduler(scheduler string) string {
+	return "fabric/" + rnScheduler(scheduler)
+}
+
+func rnSchedulerRecurringWindow(window string) string {
+	return "recurrwinp-" + window
+}
+
+func rnSchedulerOneTimeWindow(window string) string {
+	return "abswinp-" + window
+}
+
+// SchedulerAdd creates a new trigger scheduler policy.
+func (c *Client) SchedulerAdd(scheduler, descr string) error {
+
+	me := "SchedulerAdd"
+
+	rn := rnScheduler(scheduler)
+	dn := dnScheduler(scheduler)
+
+	api := "/api/node/mo/uni/" + dn + ".json"
+
+	url := c.getURL(api)
+
+	j := fmt.Sprintf(`{"trigSchedP":{"attributes":{"dn":"uni/%s","name":"%s","descr":"%s","rn":"%s","status":"created"}}}`,
+		dn, scheduler, descr, rn)
+
+	c.debugf("%s: url=%s json=%s", me, url, j)
+
+	body, errPost := c.post(url, contentTypeJSON, bytes.NewBufferString(j))
+	if errPost != nil {
+		return fmt.Errorf("%s: %v", me, errPost)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return parseJSONError(body)
+}
+
+// SchedulerDel deletes an existing trigger scheduler policy.
+func (c *Client) SchedulerDel(scheduler string) error {
+
+	me := "SchedulerDel"
+
+	dn := dnScheduler(scheduler)
+
+	api := "/api/node/mo/uni/fabric.json"
+
+	url := c.getURL(api)
+
+	j := fmt.Sprintf(`{"fabricInst":{"attributes":{"dn":"uni/fabric","status":"modified"},"children":[{"trigSchedP":{"attributes":{"dn":"uni/%s","status":"deleted"}}}]}}`,
+		dn)
+
+	c.debugf("%s: url=%s json=%s", me, url, j)
+
+	body, errPost := c.post(url, contentTypeJSON, bytes.NewBufferString(j))
+	if errPost != nil {
+		return fmt.Errorf("%s: %v", me, errPost)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return parseJSONError(body)
+}
+
+// SchedulerList retrieves the list of trigger scheduler policies.
+func (c *Client) SchedulerList() ([]map[string]interface{}, error) {
+
+	me := "SchedulerList"
+
+	key := "trigSchedP"
+
+	api := "/api/node/class/" + key + ".json"
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return jsonImdataAttributes(c, body, key, me)
+}
+
+// SchedulerRecurringWindowAdd creates a recurring window in a trigger scheduler policy.
+// day: "every-day", "odd-day", "even-day", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"
+// hour: "0".."23"
+// minute: "0".."59"
+// concurCap is the maximum number of nodes processed concurrently: "unlimited", "" (empty means default) or a number.
+func (c *Client) SchedulerRecurringWindowAdd(scheduler, window, day, hour, minute, concurCap string) error {
+
+	me := "SchedulerRecurringWindowAdd"
+
+	dnS := dnScheduler(scheduler)
+	rn := rnSchedulerRecurringWindow(window)
+
+	api := "/api/node/mo/uni/" + dnS + "/" + rn + ".json"
+
+	url := c.getURL(api)
+
+	var attrCap string
+	if concurCap != "" {
+		attrCap = fmt.Sprintf(`,"concurCap":"%s"`, concurCap)
+	}
+
+	j := fmt.Sprintf(`{"trigRecurrWindowP":{"attributes":{"dn":"uni/%s/%s","name":"%s","day":"%s","hour":"%s","minute":"%s"%s,"rn":"%s","status":"created,modified"}}}`,
+		dnS, rn, window, day, hour, minute, attrCap, rn)
+
+	c.debugf("%s: url=%s json=%s", me, url, j)
+
+	body, errPost := c.post(url, contentTypeJSON, bytes.NewBufferString(j))
+	if errPost != nil {
+		return fmt.Errorf("%s: %v", me, errPost)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return parseJSONError(body)
+}
+
+// SchedulerRecurringWindowDel deletes a recurring window from a trigger scheduler policy.
+func (c *Client) SchedulerRecurringWindowDel(scheduler, window string) error {
+
+	me := "SchedulerRecurringWindowDel"
+
+	dnS := dnScheduler(scheduler)
+	rn := rnSchedulerRecurringWindow(window)
+
+	api := "/api/node/mo/uni/" + dnS + ".json"
+
+	url := c.getURL(api)
+
+	j := fmt.Sprintf(`{"trigSchedP":{"attributes":{"dn":"uni/%s","status":"modified"},"children":[{"trigRecurrWindowP":{"attributes":{"dn":"uni/%s/%s","status":"deleted"}}}]}}`,
+		dnS, dnS, rn)
+
+	c.debugf("%s: url=%s json=%s", me, url, j)
+
+	body, errPost := c.post(url, contentTypeJSON, bytes.NewBufferString(j))
+	if errPost != nil {
+		return fmt.Errorf("%s: %v", me, errPost)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return parseJSONError(body)
+}
+
+// SchedulerRecurringWindowList retrieves the list of recurring windows from a trigger scheduler policy.
+func (c *Client) SchedulerRecurringWindowList(scheduler string) ([]map[string]interface{}, error) {
+
+	me := "SchedulerRecurringWindowList"
+
+	key := "trigRecurrWindowP"
+
+	dnS := dnScheduler(scheduler)
+
+	api := "/api/node/mo/uni/" + dnS + ".json?query-target=children&target-subtree-class=" + key
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return jsonImdataAttributes(c, body, key, me)
+}
+
+// SchedulerOneTimeWindowAdd creates a one-time window in a trigger scheduler policy.
+// date: "2017-05-12T22:00:00.000+00:00"
+// concurCap is the maximum number of nodes processed concurrently: "unlimited", "" (empty means default) or a number.
+func (c *Client) SchedulerOneTimeWindowAdd(scheduler, window, date, concurCap string) error {
+
+	me := "SchedulerOneTimeWindowAdd"
+
+	dnS := dnScheduler(scheduler)
+	rn := rnSchedulerOneTimeWindow(window)
+
+	api := "/api/node/mo/uni/" + dnS + "/" + rn + ".json"
+
+	url := c.getURL(api)
+
+	var attrCap string
+	if concurCap != "" {
+		attrCap = fmt.Sprintf(`,"concurCap":"%s"`, concurCap)
+	}
+
+	j := fmt.Sprintf(`{"trigAbsWindowP":{"attributes":{"dn":"uni/%s/%s","name":"%s","date":"%s"%s,"rn":"%s","status":"created,modified"}}}`,
+		dnS, rn, window, date, attrCap, rn)
+
+	c.debugf("%s: url=%s json=%s", me, url, j)
+
+	body, errPost := c.post(url, contentTypeJSON, bytes.NewBufferString(j))
+	if errPost != nil {
+		return fmt.Errorf("%s: %v", me, errPost)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return parseJSONError(body)
+}
+
+// SchedulerOneTimeWindowDel deletes a one-time window from a trigger scheduler policy.
+func (c *Client) SchedulerOneTimeWindowDel(scheduler, window string) error {
+
+	me := "SchedulerOneTimeWindowDel"
+
+	dnS := dnScheduler(scheduler)
+	rn := rnSchedulerOneTimeWindow(window)
+
+	api := "/api/node/mo/uni/" + dnS + ".json"
+
+	url := c.getURL(api)
+
+	j := fmt.Sprintf(`{"trigSchedP":{"attributes":{"dn":"uni/%s","status":"modified"},"children":[{"trigAbsWindowP":{"attributes":{"dn":"uni/%s/%s","status":"deleted"}}}]}}`,
+		dnS, dnS, rn)
+
+	c.debugf("%s: url=%s json=%s", me, url, j)
+
+	body, errPost := c.post(url, contentTypeJSON, bytes.NewBufferString(j))
+	if errPost != nil {
+		return fmt.Errorf("%s: %v", me, errPost)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return parseJSONError(body)
+}
+
+// SchedulerOneTimeWindowList retrieves the list of one-time windows from a trigger scheduler policy.
+func (c *Client) SchedulerOneTimeWindowList(scheduler string) ([]map[string]interface{}, error) {
+
+	me := "SchedulerOneTimeWindowList"
+
+	key := "trigAbsWindowP"
+
+	dnS := dnScheduler(scheduler)
+
+	api := "/api/node/mo/uni/" + dnS + ".json?query-target=children&target-subtree-class=" + key
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return jsonImdataAttributes(c, body, key, me)
+}
+
+// SchedulerReferenceList retrieves the DNs of the policies referencing a trigger scheduler policy.
+// Both export configurations and firmware maintenance policies are reported.
+func (c *Client) SchedulerReferenceList(scheduler string) ([]string, error) {
+
+	me := "SchedulerReferenceList"
+
+	var refs []string
+
+	for _, key := range []string{"configRsExportScheduler", "maintRsPolScheduler"} {
+
+		api := "/api/node/class/" + key + ".json" + queryOptions(queryFilter(queryEq(key, "tnTrigSchedPName", scheduler)))
+
+		url := c.getURL(api)
+
+		c.debugf("%s: url=%s", me, url)
+
+		body, errGet := c.get(url)
+		if errGet != nil {
+			return nil, fmt.Errorf("%s: %v", me, errGet)
+		}
+
+		c.debugf("%s: reply: %s", me, string(body))
+
+		attrs, errAttr := jsonImdataAttributes(c, body, key, me)
+		if errAttr != nil {
+			return nil, fmt.Errorf("%s: %v", me, errAttr)
+		}
+
+		for _, attr := range attrs {
+			dn := mapString(attr, "dn")
+			if dn == "" {
+				continue
+			}
+			refs = append(refs, extractParent(dn))
+		}
+	}
+
+	return refs, nil
+}
@@ -0,0 +1,125 @@
+package aci
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// ConfigSnapshot holds a configuration snapshot (configSnapshot) stored on APIC.
+type ConfigSnapshot struct {
+	Dn         string // Snapshot DN. Example: "uni/backupst/snapshots-[uni/fabric/configexp-snap1]/file-[ce2_snap1-2017-05-12T10-04-33.tar.gz]"
+	Name       string
+	FileName   string // Snapshot file name. Example: "ce2_snap1-2017-05-12T10-04-33.tar.gz"
+	CreateTime string // Creation timestamp.
+	Descr      string
+	RootDn     string // Root of the exported subtree. Empty means whole configuration.
+}
+
+func configSnapshotFromAttributes(attr map[string]interface{}) ConfigSnapshot {
+	return ConfigSnapshot{
+		Dn:         mapString(attr, "dn"),
+		Name:       mapString(attr, "name"),
+		FileName:   mapString(attr, "fileName"),
+		CreateTime: mapString(attr, "createTime"),
+		Descr:      mapString(attr, "descr"),
+		RootDn:     mapString(attr, "rootDn"),
+	}
+}
+
+// ConfigSnapshotList retrieves the list of configuration snapshots, most recent first.
+func (c *Client) ConfigSnapshotList() ([]ConfigSnapshot, error) {
+
+	me := "ConfigSnapshotList"
+
+	key := "configSnapshot"
+
+	api := "/api/node/class/" + key + ".json?order-by=" + key + ".createTime|desc"
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	attrs, errAttr := jsonImdataAttributes(c, body, key, me)
+	if errAttr != nil {
+		return nil, fmt.Errorf("%s: %v", me, errAttr)
+	}
+
+	list := make([]ConfigSnapshot, 0, len(attrs))
+	for _, attr := range attrs {
+		list = append(list, configSnapshotFromAttributes(attr))
+	}
+
+	return list, nil
+}
+
+// ConfigSnapshotCreate takes a snapshot of the whole configuration now, using the export configuration named config.
+// The export configuration is created if it does not exist.
+// The finished job is returned. Use ConfigJob.Success() to check the job result.
+func (c *Client) ConfigSnapshotCreate(config, descr string, timeout time.Duration) (ConfigJob, error) {
+	return c.ExportConfigurationOneTimeRunWait(config, ExportFormatJSON, "", "", true, descr, timeout)
+}
+
+// ConfigSnapshotCompare compares two snapshots, given by their DNs.
+// The difference is returned unparsed, exactly as produced by APIC for /mqapi2/snapshots.diff.xml:
+// an XML document listing, for each managed object that differs between the snapshots,
+// its DN and the attributes added, removed or changed from snapshotDn1 to snapshotDn2.
+// It is the same report shown by the APIC GUI snapshot compare, meant to be read by an operator.
+func (c *Client) ConfigSnapshotCompare(snapshotDn1, snapshotDn2 string) (string, error) {
+
+	me := "ConfigSnapshotCompare"
+
+	api := "/mqapi2/snapshots.diff.xml?s1dn=" + url.QueryEscape(snapshotDn1) + "&s2dn=" + url.QueryEscape(snapshotDn2)
+
+	u := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, u)
+
+	body, errGet := c.get(u)
+	if errGet != nil {
+		return "", fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	return string(body), nil
+}
+
+func rnRollbackConfig(config string) string {
+	return "configrlbk-" + config
+}
+
+// ConfigSnapshotRollback rolls the configuration back to a snapshot, given by its file name, and waits for the resulting job to finish.
+// The rollback is performed by the rollback policy (configRollbackP) named config, which is created if it does not exist.
+// The finished job is returned. Use ConfigJob.Success() to check the job result.
+func (c *Client) ConfigSnapshotRollback(config, fileName string, timeout time.Duration) (ConfigJob, error) {
+
+	me := "ConfigSnapshotRollback"
+
+	rn := rnRollbackConfig(config)
+
+	dn := "fabric/" + rn
+
+	jobs, errList := c.configJobList(me, dn)
+	if errList != nil {
+		return ConfigJob{}, errList
+	}
+
+	known := configJobKnown(jobs)
+
+	j := fmt.Sprintf(`{"configRollbackP":{"attributes":{"dn":"uni/%s","name":"%s","snapshotName":"%s","adminSt":"triggered","rn":"%s","status":"created,modified"}}}`,
+		dn, config, fileName, rn)
+
+	if errPost := c.moPost(me, dn, j); errPost != nil {
+		return ConfigJob{}, errPost
+	}
+
+	return c.configJobWait(me, dn, known, timeout)
+}
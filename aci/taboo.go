@@ -0,0 +1,100 @@
+package aci
+
+import (
+	"fmt"
+	"strings"
+)
+
+func rnTaboo(taboo string) string {
+	return "taboo-" + taboo
+}
+
+func dnTaboo(tenant, taboo string) string {
+	return rnTenant(tenant) + "/" + rnTaboo(taboo)
+}
+
+func rnTabooSubject(subject string) string {
+	return "tsubj-" + subject
+}
+
+func dnTabooSubject(tenant, taboo, subject string) string {
+	return dnTaboo(tenant, taboo) + "/" + rnTabooSubject(subject)
+}
+
+// TabooContractAdd creates a new taboo contract. Traffic matching a taboo contract is denied.
+func (c *Client) TabooContractAdd(tenant, taboo, descr string) error {
+	return c.tenantPolicyAdd("TabooContractAdd", tenant, "vzTaboo", rnTaboo(taboo), taboo, descr, "")
+}
+
+// TabooContractDel deletes an existing taboo contract.
+func (c *Client) TabooContractDel(tenant, taboo string) error {
+	return c.moChildDel("TabooContractDel", "fvTenant", rnTenant(tenant), "vzTaboo", rnTaboo(taboo))
+}
+
+// TabooContractList retrieves the list of taboo contracts in a tenant.
+func (c *Client) TabooContractList(tenant string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("TabooContractList", rnTenant(tenant), "vzTaboo")
+}
+
+// tabooSubjectJSON builds the vzTSubj object for a taboo contract subject, with one deny rule per filter.
+func tabooSubjectJSON(tenant, taboo, subject string, filters []string, descr string) string {
+
+	rn := rnTabooSubject(subject)
+
+	dn := dnTabooSubject(tenant, taboo, subject)
+
+	children := make([]string, 0, len(filters))
+	for _, f := range filters {
+		children = append(children, fmt.Sprintf(`{"vzRsDenyRule":{"attributes":{"tnVzFilterName":"%s","status":"created,modified"}}}`, f))
+	}
+
+	return fmt.Sprintf(`{"vzTSubj":{"attributes":{"dn":"uni/%s","name":"%s","descr":"%s","rn":"%s","status":"created"},"children":[%s]}}`,
+		dn, subject, descr, rn, strings.Join(children, ","))
+}
+
+// TabooSubjectAdd creates a subject in a taboo contract, denying traffic matched by filters.
+func (c *Client) TabooSubjectAdd(tenant, taboo, subject string, filters []string, descr string) error {
+	return c.moPost("TabooSubjectAdd", dnTabooSubject(tenant, taboo, subject), tabooSubjectJSON(tenant, taboo, subject, filters, descr))
+}
+
+// TabooSubjectDel deletes a subject from a taboo contract.
+func (c *Client) TabooSubjectDel(tenant, taboo, subject string) error {
+	return c.moChildDel("TabooSubjectDel", "vzTaboo", dnTaboo(tenant, taboo), "vzTSubj", rnTabooSubject(subject))
+}
+
+// TabooSubjectList retrieves the list of subjects in a taboo contract.
+func (c *Client) TabooSubjectList(tenant, taboo string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("TabooSubjectList", dnTaboo(tenant, taboo), "vzTSubj")
+}
+
+// TabooSubjectFilterAdd adds a deny filter to a taboo contract subject.
+func (c *Client) TabooSubjectFilterAdd(tenant, taboo, subject, filter string) error {
+	j := fmt.Sprintf(`{"vzRsDenyRule":{"attributes":{"tnVzFilterName":"%s","status":"created,modified"}}}`, filter)
+	return c.moPost("TabooSubjectFilterAdd", dnTabooSubject(tenant, taboo, subject), j)
+}
+
+// TabooSubjectFilterDel removes a deny filter from a taboo contract subject.
+func (c *Client) TabooSubjectFilterDel(tenant, taboo, subject, filter string) error {
+	return c.moChildDel("TabooSubjectFilterDel", "vzTSubj", dnTabooSubject(tenant, taboo, subject), "vzRsDenyRule", "rsdenyRule-"+filter)
+}
+
+// TabooSubjectFilterList retrieves the list of deny filters in a taboo contract subject.
+func (c *Client) TabooSubjectFilterList(tenant, taboo, subject string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("TabooSubjectFilterList", dnTabooSubject(tenant, taboo, subject), "vzRsDenyRule")
+}
+
+// EPGTabooContractAdd protects EPG with a taboo contract.
+func (c *Client) EPGTabooContractAdd(tenant, applicationProfile, epg, taboo string) error {
+	j := fmt.Sprintf(`{"fvRsProtBy":{"attributes":{"tnVzTabooName":"%s","status":"created,modified"}}}`, taboo)
+	return c.moPost("EPGTabooContractAdd", dnAEPG(tenant, applicationProfile, epg), j)
+}
+
+// EPGTabooContractDel removes a taboo contract from EPG.
+func (c *Client) EPGTabooContractDel(tenant, applicationProfile, epg, taboo string) error {
+	return c.moChildDel("EPGTabooContractDel", "fvAEPg", dnAEPG(tenant, applicationProfile, epg), "fvRsProtBy", "rsprotBy-"+taboo)
+}
+
+// EPGTabooContractList retrieves the list of taboo contracts protecting EPG.
+func (c *Client) EPGTabooContractList(tenant, applicationProfile, epg string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("EPGTabooContractList", dnAEPG(tenant, applicationProfile, epg), "fvRsProtBy")
+}
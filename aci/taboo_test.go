@@ -0,0 +1,38 @@
+package aci
+
+import (
+	"testing"
+)
+
+func TestTabooSubjectJSON(t *testing.T) {
+	tabooSubjectJSONTest(t, nil)
+	tabooSubjectJSONTest(t, []string{"ssh"})
+	tabooSubjectJSONTest(t, []string{"ssh", "telnet", "rdp"})
+}
+
+func tabooSubjectJSONTest(t *testing.T, filters []string) {
+	j := tabooSubjectJSON("t1", "deny1", "subj1", filters, "")
+
+	objs, errObj := jsonImdataObjects(testDebug{t}, []byte(`{"totalCount":"1","imdata":[`+j+`]}`), "TestTabooSubjectJSON")
+	if errObj != nil {
+		t.Fatalf("filters=%v bad json: %v: %s", filters, errObj, j)
+	}
+	if len(objs) != 1 || objs[0].class != "vzTSubj" {
+		t.Fatalf("filters=%v want one vzTSubj: %s", filters, j)
+	}
+
+	subj := objs[0]
+	if dn := mapString(subj.attr, "dn"); dn != "uni/tn-t1/taboo-deny1/tsubj-subj1" {
+		t.Errorf("filters=%v bad dn: %s", filters, dn)
+	}
+
+	rules := subj.childrenByClass("vzRsDenyRule")
+	if len(rules) != len(filters) || len(subj.children) != len(filters) {
+		t.Fatalf("filters=%v want %d deny rules: %s", filters, len(filters), j)
+	}
+	for i, r := range rules {
+		if got := mapString(r.attr, "tnVzFilterName"); got != filters[i] {
+			t.Errorf("filters=%v rule %d want=%s got=%s", filters, i, filters[i], got)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package aci
+
+import (
+	"fmt"
+)
+
+// VpcProtectionGroup holds an explicit vPC protection group (fabricExplicitGEp), pairing two leaf nodes.
+type VpcProtectionGroup struct {
+	Name  string
+	ID    string   // Logical pair ID.
+	Nodes []string // Leaf node IDs. Example: []string{"101", "102"}
+}
+
+func rnVpcProtectionGroup(group string) string {
+	return "expgep-" + group
+}
+
+func dnVpcProtectionGroup(group string) string {
+	return "fabric/protpol/" + rnVpcProtectionGroup(group)
+}
+
+// VpcProtectionGroupAdd creates an explicit vPC protection group pairing two leaf nodes.
+// id is the logical pair ID. Example: id="101" pod="1" node1="101" node2="102"
+func (c *Client) VpcProtectionGroupAdd(group, id, pod, node1, node2 string) error {
+
+	me := "VpcProtectionGroupAdd"
+
+	rn := rnVpcProtectionGroup(group)
+	dn := dnVpcProtectionGroup(group)
+
+	j := fmt.Sprintf(`{"fabricExplicitGEp":{"attributes":{"dn":"uni/%s","name":"%s","id":"%s","rn":"%s","status":"created"},"children":[{"fabricNodePEp":{"attributes":{"dn":"uni/%s/nodepe-%s","id":"%s","podId":"%s","rn":"nodepe-%s","status":"created"}}},{"fabricNodePEp":{"attributes":{"dn":"uni/%s/nodepe-%s","id":"%s","podId":"%s","rn":"nodepe-%s","status":"created"}}},{"fabricRsVpcInstPol":{"attributes":{"tnVpcInstPolName":"default","status":"created,modified"}}}]}}`,
+		dn, group, id, rn, dn, node1, node1, pod, node1, dn, node2, node2, pod, node2)
+
+	return c.moPost(me, dn, j)
+}
+
+// VpcProtectionGroupDel deletes an explicit vPC protection group.
+func (c *Client) VpcProtectionGroupDel(group string) error {
+
+	me := "VpcProtectionGroupDel"
+
+	dn := dnVpcProtectionGroup(group)
+
+	j := fmt.Sprintf(`{"fabricProtPol":{"attributes":{"dn":"uni/fabric/protpol","status":"modified"},"children":[{"fabricExplicitGEp":{"attributes":{"dn":"uni/%s","status":"deleted"}}}]}}`,
+		dn)
+
+	return c.moPost(me, "fabric/protpol", j)
+}
+
+// VpcProtectionGroupList retrieves the list of explicit vPC protection groups, with their leaf node pairs.
+func (c *Client) VpcProtectionGroupList() ([]VpcProtectionGroup, error) {
+
+	me := "VpcProtectionGroupList"
+
+	key := "fabricExplicitGEp"
+
+	api := "/api/node/class/" + key + ".json?rsp-subtree=children&rsp-subtree-class=fabricNodePEp"
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return nil, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	objs, errObj := jsonImdataObjects(c, body, me)
+	if errObj != nil {
+		return nil, errObj
+	}
+
+	var list []VpcProtectionGroup
+	for _, obj := range objs {
+		if obj.class != key {
+			continue
+		}
+		g := VpcProtectionGroup{
+			Name: mapString(obj.attr, "name"),
+			ID:   mapString(obj.attr, "id"),
+		}
+		for _, n := range obj.childrenByClass("fabricNodePEp") {
+			g.Nodes = append(g.Nodes, mapString(n.attr, "id"))
+		}
+		list = append(list, g)
+	}
+
+	return list, nil
+}
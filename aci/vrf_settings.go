@@ -0,0 +1,250 @@
+package aci
+
+import (
+	"fmt"
+)
+
+// VRF policy control enforcement directions.
+const (
+	VrfEnforcementIngress = "ingress"
+	VrfEnforcementEgress  = "egress"
+)
+
+// Route target types for VrfRouteTargetAdd.
+const (
+	RouteTargetImport = "import"
+	RouteTargetExport = "export"
+)
+
+// VrfRouteTarget holds a BGP route target for a VRF (bgpRtTarget).
+type VrfRouteTarget struct {
+	AddressFamily string // BGPAddressFamilyIPv4, BGPAddressFamilyIPv6
+	RouteTarget   string // Example: "route-target:as2-nn2:65000:100"
+	Type          string // RouteTargetImport, RouteTargetExport
+}
+
+// Vrf holds the settings of a VRF.
+type Vrf struct {
+	Dn                      string
+	Name                    string
+	Descr                   string
+	Enforced                bool   // Policy control is enforced.
+	EnforcementDirection    string // VrfEnforcementIngress, VrfEnforcementEgress
+	BDEnforcement           bool   // BD enforcement: endpoints may only ping the gateway of their own BD.
+	IPDataPlaneLearning     bool
+	PreferredGroup          bool // Preferred group is enabled for the VRF (vzAny).
+	EndpointRetentionPolicy string
+	BGPTimersPolicy         string
+	OSPFTimersPolicy        string
+	RouteTargets            []VrfRouteTarget
+	AnyProvided             []string // Contracts provided by vzAny.
+	AnyConsumed             []string // Contracts consumed by vzAny.
+}
+
+func dnVrfAny(tenant, vrf string) string {
+	return dnVrf(tenant, vrf) + "/any"
+}
+
+func rnRouteTargetProfile(af string) string {
+	return "rtp-" + af
+}
+
+func rnRouteTarget(rt, rtType string) string {
+	return "rt-[" + rt + "]-" + rtType
+}
+
+func vrfFromObject(obj imdataObject) Vrf {
+
+	v := Vrf{
+		Dn:                   mapString(obj.attr, "dn"),
+		Name:                 mapString(obj.attr, "name"),
+		Descr:                mapString(obj.attr, "descr"),
+		Enforced:             mapString(obj.attr, "pcEnfPref") == "enforced",
+		EnforcementDirection: mapString(obj.attr, "pcEnfDir"),
+		BDEnforcement:        mapString(obj.attr, "bdEnforcedEnable") == "yes",
+		IPDataPlaneLearning:  mapString(obj.attr, "ipDataPlaneLearning") == "enabled",
+	}
+
+	for _, r := range obj.childrenByClass("fvRsCtxToEpRet") {
+		v.EndpointRetentionPolicy = mapString(r.attr, "tnFvEpRetPolName")
+	}
+	for _, r := range obj.childrenByClass("fvRsBgpCtxPol") {
+		v.BGPTimersPolicy = mapString(r.attr, "tnBgpCtxPolName")
+	}
+	for _, r := range obj.childrenByClass("fvRsOspfCtxPol") {
+		v.OSPFTimersPolicy = mapString(r.attr, "tnOspfCtxPolName")
+	}
+
+	for _, rtp := range obj.childrenByClass("bgpRtTargetP") {
+		af := mapString(rtp.attr, "af")
+		for _, rt := range rtp.childrenByClass("bgpRtTarget") {
+			v.RouteTargets = append(v.RouteTargets, VrfRouteTarget{
+				AddressFamily: af,
+				RouteTarget:   mapString(rt.attr, "rt"),
+				Type:          mapString(rt.attr, "type"),
+			})
+		}
+	}
+
+	for _, a := range obj.childrenByClass("vzAny") {
+		v.PreferredGroup = mapString(a.attr, "prefGrMemb") == "enabled"
+		for _, r := range a.childrenByClass("vzRsAnyToProv") {
+			v.AnyProvided = append(v.AnyProvided, mapString(r.attr, "tnVzBrCPName"))
+		}
+		for _, r := range a.childrenByClass("vzRsAnyToCons") {
+			v.AnyConsumed = append(v.AnyConsumed, mapString(r.attr, "tnVzBrCPName"))
+		}
+	}
+
+	return v
+}
+
+// VrfGet retrieves the settings of a VRF.
+func (c *Client) VrfGet(tenant, vrf string) (Vrf, error) {
+
+	me := "VrfGet"
+
+	key := "fvCtx"
+
+	dn := dnVrf(tenant, vrf)
+
+	api := "/api/node/mo/uni/" + dn + ".json?rsp-subtree=full&rsp-subtree-class=fvRsCtxToEpRet,fvRsBgpCtxPol,fvRsOspfCtxPol,bgpRtTargetP,bgpRtTarget,vzAny,vzRsAnyToProv,vzRsAnyToCons"
+
+	url := c.getURL(api)
+
+	c.debugf("%s: url=%s", me, url)
+
+	body, errGet := c.get(url)
+	if errGet != nil {
+		return Vrf{}, fmt.Errorf("%s: %v", me, errGet)
+	}
+
+	c.debugf("%s: reply: %s", me, string(body))
+
+	objs, errObj := jsonImdataObjects(c, body, me)
+	if errObj != nil {
+		return Vrf{}, errObj
+	}
+
+	if len(objs) != 1 || objs[0].class != key {
+		return Vrf{}, fmt.Errorf("%s: bad object count=%d", me, len(objs))
+	}
+
+	return vrfFromObject(objs[0]), nil
+}
+
+// vrfAttrSet modifies an attribute of an existing VRF.
+func (c *Client) vrfAttrSet(me, tenant, vrf, attr, value string) error {
+
+	dn := dnVrf(tenant, vrf)
+
+	j := fmt.Sprintf(`{"fvCtx":{"attributes":{"dn":"uni/%s","%s":"%s","status":"modified"}}}`,
+		dn, attr, value)
+
+	return c.moPost(me, dn, j)
+}
+
+// VrfEnforcementDirectionSet sets the VRF policy control enforcement direction.
+// direction: VrfEnforcementIngress, VrfEnforcementEgress
+func (c *Client) VrfEnforcementDirectionSet(tenant, vrf, direction string) error {
+	return c.vrfAttrSet("VrfEnforcementDirectionSet", tenant, vrf, "pcEnfDir", direction)
+}
+
+// VrfBDEnforcementSet enables/disables BD enforcement for the VRF.
+func (c *Client) VrfBDEnforcementSet(tenant, vrf string, enable bool) error {
+	return c.vrfAttrSet("VrfBDEnforcementSet", tenant, vrf, "bdEnforcedEnable", yesNo(enable))
+}
+
+// VrfIPDataPlaneLearningSet enables/disables IP data-plane learning for the VRF.
+func (c *Client) VrfIPDataPlaneLearningSet(tenant, vrf string, enable bool) error {
+	return c.vrfAttrSet("VrfIPDataPlaneLearningSet", tenant, vrf, "ipDataPlaneLearning", enabledDisabled(enable))
+}
+
+// VrfPreferredGroupSet enables/disables the preferred group for the VRF.
+// Use ApplicationEPGPreferredGroupSet() to include EPGs in the preferred group.
+func (c *Client) VrfPreferredGroupSet(tenant, vrf string, enable bool) error {
+	dn := dnVrfAny(tenant, vrf)
+	j := fmt.Sprintf(`{"vzAny":{"attributes":{"dn":"uni/%s","prefGrMemb":"%s","status":"created,modified"}}}`,
+		dn, enabledDisabled(enable))
+	return c.moPost("VrfPreferredGroupSet", dn, j)
+}
+
+// VrfEndpointRetentionSet attaches an endpoint retention policy to the VRF.
+func (c *Client) VrfEndpointRetentionSet(tenant, vrf, policy string) error {
+	j := fmt.Sprintf(`{"fvRsCtxToEpRet":{"attributes":{"tnFvEpRetPolName":"%s","status":"created,modified"}}}`, policy)
+	return c.moPost("VrfEndpointRetentionSet", dnVrf(tenant, vrf), j)
+}
+
+// VrfOSPFTimersSet attaches an OSPF timers policy to the VRF.
+func (c *Client) VrfOSPFTimersSet(tenant, vrf, policy string) error {
+	j := fmt.Sprintf(`{"fvRsOspfCtxPol":{"attributes":{"tnOspfCtxPolName":"%s","status":"created,modified"}}}`, policy)
+	return c.moPost("VrfOSPFTimersSet", dnVrf(tenant, vrf), j)
+}
+
+// OSPFTimersPolicyAdd creates an OSPF timers policy in a tenant.
+// spfInitial, spfHold, spfMax are SPF throttle intervals in milliseconds. "" (empty means default)
+// maxEcmp is the maximum number of ECMP paths. "" (empty means default)
+func (c *Client) OSPFTimersPolicyAdd(tenant, policy, spfInitial, spfHold, spfMax, maxEcmp, descr string) error {
+	attrs := optionalAttr("spfInitIntvl", spfInitial) +
+		optionalAttr("spfHoldIntvl", spfHold) +
+		optionalAttr("spfMaxIntvl", spfMax) +
+		optionalAttr("maxEcmp", maxEcmp)
+	return c.tenantPolicyAdd("OSPFTimersPolicyAdd", tenant, "ospfCtxPol", "ospfCtxP-"+policy, policy, descr, attrs)
+}
+
+// OSPFTimersPolicyDel deletes an OSPF timers policy from a tenant.
+func (c *Client) OSPFTimersPolicyDel(tenant, policy string) error {
+	return c.moChildDel("OSPFTimersPolicyDel", "fvTenant", rnTenant(tenant), "ospfCtxPol", "ospfCtxP-"+policy)
+}
+
+// OSPFTimersPolicyList retrieves the list of OSPF timers policies in a tenant.
+func (c *Client) OSPFTimersPolicyList(tenant string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("OSPFTimersPolicyList", rnTenant(tenant), "ospfCtxPol")
+}
+
+// VrfRouteTargetAdd adds a BGP route target to the VRF.
+// af: BGPAddressFamilyIPv4, BGPAddressFamilyIPv6
+// Example: rt="route-target:as2-nn2:65000:100" rtType=RouteTargetImport
+func (c *Client) VrfRouteTargetAdd(tenant, vrf, af, rt, rtType string) error {
+	j := fmt.Sprintf(`{"bgpRtTargetP":{"attributes":{"af":"%s","status":"created,modified"},"children":[{"bgpRtTarget":{"attributes":{"rt":"%s","type":"%s","status":"created,modified"}}}]}}`,
+		af, rt, rtType)
+	return c.moPost("VrfRouteTargetAdd", dnVrf(tenant, vrf), j)
+}
+
+// VrfRouteTargetDel deletes a BGP route target from the VRF.
+func (c *Client) VrfRouteTargetDel(tenant, vrf, af, rt, rtType string) error {
+	dn := dnVrf(tenant, vrf) + "/" + rnRouteTargetProfile(af)
+	return c.moChildDel("VrfRouteTargetDel", "bgpRtTargetP", dn, "bgpRtTarget", rnRouteTarget(rt, rtType))
+}
+
+// VrfAnyContractProvidedAdd attaches contract as provided by vzAny (all EPGs in the VRF).
+func (c *Client) VrfAnyContractProvidedAdd(tenant, vrf, contract string) error {
+	j := fmt.Sprintf(`{"vzRsAnyToProv":{"attributes":{"tnVzBrCPName":"%s","status":"created,modified"}}}`, contract)
+	return c.moPost("VrfAnyContractProvidedAdd", dnVrfAny(tenant, vrf), j)
+}
+
+// VrfAnyContractProvidedDel detaches provided contract from vzAny.
+func (c *Client) VrfAnyContractProvidedDel(tenant, vrf, contract string) error {
+	return c.moChildDel("VrfAnyContractProvidedDel", "vzAny", dnVrfAny(tenant, vrf), "vzRsAnyToProv", "rsanyToProv-"+contract)
+}
+
+// VrfAnyContractProvidedList retrieves the list of contracts provided by vzAny.
+func (c *Client) VrfAnyContractProvidedList(tenant, vrf string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("VrfAnyContractProvidedList", dnVrfAny(tenant, vrf), "vzRsAnyToProv")
+}
+
+// VrfAnyContractConsumedAdd attaches contract as consumed by vzAny (all EPGs in the VRF).
+func (c *Client) VrfAnyContractConsumedAdd(tenant, vrf, contract string) error {
+	j := fmt.Sprintf(`{"vzRsAnyToCons":{"attributes":{"tnVzBrCPName":"%s","status":"created,modified"}}}`, contract)
+	return c.moPost("VrfAnyContractConsumedAdd", dnVrfAny(tenant, vrf), j)
+}
+
+// VrfAnyContractConsumedDel detaches consumed contract from vzAny.
+func (c *Client) VrfAnyContractConsumedDel(tenant, vrf, contract string) error {
+	return c.moChildDel("VrfAnyContractConsumedDel", "vzAny", dnVrfAny(tenant, vrf), "vzRsAnyToCons", "rsanyToCons-"+contract)
+}
+
+// VrfAnyContractConsumedList retrieves the list of contracts consumed by vzAny.
+func (c *Client) VrfAnyContractConsumedList(tenant, vrf string) ([]map[string]interface{}, error) {
+	return c.moChildrenAttributes("VrfAnyContractConsumedList", dnVrfAny(tenant, vrf), "vzRsAnyToCons")
+}
@@ -0,0 +1,43 @@
+package aci
+
+import (
+	"testing"
+)
+
+func TestVrfFromObject(t *testing.T) {
+
+	body := `{"totalCount":"1","imdata":[{"fvCtx":{"attributes":{"dn":"uni/tn-prod/ctx-v1","name":"v1","pcEnfPref":"enforced","pcEnfDir":"ingress","bdEnforcedEnable":"no","ipDataPlaneLearning":"enabled"},"children":[
+{"fvRsBgpCtxPol":{"attributes":{"rn":"rsbgpCtxPol","tnBgpCtxPolName":"bgp-timers"}}},
+{"bgpRtTargetP":{"attributes":{"rn":"rtp-ipv4-ucast","af":"ipv4-ucast"},"children":[
+	{"bgpRtTarget":{"attributes":{"rn":"rt-[route-target:as2-nn2:65000:100]-import","rt":"route-target:as2-nn2:65000:100","type":"import"}}},
+	{"bgpRtTarget":{"attributes":{"rn":"rt-[route-target:as2-nn2:65000:100]-export","rt":"route-target:as2-nn2:65000:100","type":"export"}}}]}},
+{"vzAny":{"attributes":{"rn":"any","prefGrMemb":"enabled"},"children":[
+	{"vzRsAnyToProv":{"attributes":{"rn":"rsanyToProv-permit-any","tnVzBrCPName":"permit-any"}}},
+	{"vzRsAnyToCons":{"attributes":{"rn":"rsanyToCons-permit-any","tnVzBrCPName":"permit-any"}}}]}}]}}]}`
+
+	objs, errObj := jsonImdataObjects(testDebug{t}, []byte(body), "TestVrfFromObject")
+	if errObj != nil {
+		t.Fatalf("parse error: %v", errObj)
+	}
+	if len(objs) != 1 {
+		t.Fatalf("want 1 object, got %d", len(objs))
+	}
+
+	v := vrfFromObject(objs[0])
+
+	if !v.Enforced || v.EnforcementDirection != VrfEnforcementIngress || v.BDEnforcement || !v.IPDataPlaneLearning {
+		t.Errorf("unexpected settings: %+v", v)
+	}
+	if !v.PreferredGroup {
+		t.Errorf("preferred group not enabled: %+v", v)
+	}
+	if v.BGPTimersPolicy != "bgp-timers" {
+		t.Errorf("want bgp timers policy=bgp-timers got=%s", v.BGPTimersPolicy)
+	}
+	if len(v.RouteTargets) != 2 || v.RouteTargets[0].AddressFamily != BGPAddressFamilyIPv4 || v.RouteTargets[1].Type != RouteTargetExport {
+		t.Errorf("unexpected route targets: %+v", v.RouteTargets)
+	}
+	if len(v.AnyProvided) != 1 || len(v.AnyConsumed) != 1 || v.AnyProvided[0] != "permit-any" {
+		t.Errorf("unexpected vzAny contracts: provided=%v consumed=%v", v.AnyProvided, v.AnyConsumed)
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/udhos/acigo/aci"
+)
+
+func main() {
+
+	debug := os.Getenv("DEBUG") != ""
+
+	if len(os.Args) < 2 {
+		log.Fatalf("usage: %s audit|event|fault [hours] [affected] [action] [user]", os.Args[0])
+	}
+
+	a, errLogin := login(debug)
+	if errLogin != nil {
+		log.Printf("exiting: %v", errLogin)
+		return
+	}
+
+	defer logout(a)
+
+	execute(a, os.Args[1], os.Args[2:])
+}
+
+func execute(a *aci.Client, cmd string, args []string) {
+
+	f := aci.RecordFilter{PageSize: 100}
+
+	if len(args) > 0 {
+		hours, errHours := strconv.Atoi(args[0])
+		if errHours != nil {
+			log.Fatalf("bad hours=%s: %v", args[0], errHours)
+		}
+		f.Since = time.Now().Add(-time.Duration(hours) * time.Hour)
+	}
+	if len(args) > 1 {
+		f.Affected = args[1]
+	}
+	if len(args) > 2 {
+		f.Action = args[2]
+	}
+	if len(args) > 3 {
+		f.User = args[3]
+	}
+
+	var records []aci.Record
+	var errQuery error
+
+	switch cmd {
+	case "audit":
+		records, errQuery = a.AuditLogQuery(f)
+	case "event":
+		records, errQuery = a.EventRecordQuery(f)
+	case "fault":
+		records, errQuery = a.FaultRecordQuery(f)
+	default:
+		log.Printf("unknown command: %s", cmd)
+		return
+	}
+
+	if errQuery != nil {
+		log.Printf("FAILURE: %s error: %v", cmd, errQuery)
+		return
+	}
+
+	for _, r := range records {
+		log.Printf("FOUND record: created=%s user=%s ind=%s affected=%s code=%s severity=%s descr=%s changeSet=%s", r.Created, r.User, r.Ind, r.Affected, r.Code, r.Severity, r.Descr, r.ChangeSet)
+	}
+}
+
+func login(debug bool) (*aci.Client, error) {
+
+	a, errNew := aci.New(aci.ClientOptions{Debug: debug})
+	if errNew != nil {
+		return nil, fmt.Errorf("login new client error: %v", errNew)
+	}
+
+	errLogin := a.Login()
+	if errLogin != nil {
+		return nil, fmt.Errorf("login error: %v", errLogin)
+	}
+
+	return a, nil
+}
+
+func logout(a *aci.Client) {
+	errLogout := a.Logout()
+	if errLogout != nil {
+		log.Printf("logout error: %v", errLogout)
+		return
+	}
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/udhos/acigo/aci"
+)
+
+func main() {
+
+	debug := os.Getenv("DEBUG") != ""
+
+	if len(os.Args) < 2 {
+		log.Fatalf("usage: %s mac|ip|vm|epg|port|history args", os.Args[0])
+	}
+
+	a, errLogin := login(debug)
+	if errLogin != nil {
+		log.Printf("exiting: %v", errLogin)
+		return
+	}
+
+	defer logout(a)
+
+	execute(a, os.Args[1], os.Args[2:])
+}
+
+func execute(a *aci.Client, cmd string, args []string) {
+
+	var list []aci.Endpoint
+	var err error
+
+	switch cmd {
+	case "mac":
+		if len(args) < 1 {
+			log.Fatalf("usage: %s mac mac", os.Args[0])
+		}
+		list, err = a.EndpointFindByMAC(args[0])
+	case "ip":
+		if len(args) < 1 {
+			log.Fatalf("usage: %s ip ip", os.Args[0])
+		}
+		list, err = a.EndpointFindByIP(args[0])
+	case "vm":
+		if len(args) < 1 {
+			log.Fatalf("usage: %s vm vm-name", os.Args[0])
+		}
+		list, err = a.EndpointFindByVM(args[0])
+	case "epg":
+		if len(args) < 3 {
+			log.Fatalf("usage: %s epg tenant application-profile epg", os.Args[0])
+		}
+		list, err = a.EndpointListByEPG(args[0], args[1], args[2])
+	case "port":
+		if len(args) < 3 {
+			log.Fatalf("usage: %s port pod node port", os.Args[0])
+		}
+		list, err = a.EndpointListByInterface(args[0], args[1], args[2])
+	case "history":
+		if len(args) < 1 {
+			log.Fatalf("usage: %s history mac", os.Args[0])
+		}
+		records, errHist := a.EndpointHistory(args[0])
+		if errHist != nil {
+			log.Printf("FAILURE: history error: %v", errHist)
+			return
+		}
+		for _, r := range records {
+			log.Printf("FOUND record: created=%s affected=%s descr=%s", r["created"], r["affected"], r["descr"])
+		}
+		return
+	default:
+		log.Printf("unknown command: %s", cmd)
+		return
+	}
+
+	if err != nil {
+		log.Printf("FAILURE: %s error: %v", cmd, err)
+		return
+	}
+
+	for _, e := range list {
+		log.Printf("FOUND endpoint: mac=%s ips=%s encap=%s tenant=%s ap=%s epg=%s", e.Mac, strings.Join(e.IPs, ","), e.Encap, e.Tenant, e.ApplicationProfile, e.EPG)
+		for _, p := range e.Paths {
+			log.Printf("  path: kind=%s pod=%s nodes=%s interface=%s", p.Kind, p.Pod, strings.Join(p.Nodes, ","), p.Interface)
+		}
+	}
+}
+
+func login(debug bool) (*aci.Client, error) {
+
+	a, errNew := aci.New(aci.ClientOptions{Debug: debug})
+	if errNew != nil {
+		return nil, fmt.Errorf("login new client error: %v", errNew)
+	}
+
+	errLogin := a.Login()
+	if errLogin != nil {
+		return nil, fmt.Errorf("login error: %v", errLogin)
+	}
+
+	return a, nil
+}
+
+func logout(a *aci.Client) {
+	errLogout := a.Logout()
+	if errLogout != nil {
+		log.Printf("logout error: %v", errLogout)
+		return
+	}
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/udhos/acigo/aci"
+)
+
+func main() {
+
+	debug := os.Getenv("DEBUG") != ""
+
+	if len(os.Args) < 5 {
+		log.Fatalf("usage: %s list|dom-add|dom-del|path-add|path-del args", os.Args[0])
+	}
+
+	tenant := os.Args[2]
+	ap := os.Args[3]
+	epg := os.Args[4]
+
+	a, errLogin := login(debug)
+	if errLogin != nil {
+		log.Printf("exiting: %v", errLogin)
+		return
+	}
+
+	defer logout(a)
+
+	execute(a, os.Args[1], os.Args[2:])
+
+	// display existing
+	{
+		list, errList := a.ApplicationEPGDomainList(tenant, ap, epg)
+		if errList != nil {
+			log.Printf("could not list domains: %v", errList)
+			return
+		}
+		for _, d := range list {
+			log.Printf("FOUND domain=%s instrImedcy=%s resImedcy=%s", d["tDn"], d["instrImedcy"], d["resImedcy"])
+		}
+	}
+	{
+		list, errList := a.ApplicationEPGStaticPathList(tenant, ap, epg)
+		if errList != nil {
+			log.Printf("could not list static paths: %v", errList)
+			return
+		}
+		for _, p := range list {
+			log.Printf("FOUND static path=%s encap=%s mode=%s immediacy=%s", p.Path, p.Encap, p.Mode, p.Immediacy)
+		}
+	}
+}
+
+func execute(a *aci.Client, cmd string, args []string) {
+	switch cmd {
+	case "dom-add":
+		if len(args) < 4 {
+			log.Fatalf("usage: %s dom-add tenant application-profile epg physical-domain", os.Args[0])
+		}
+		tenant, ap, epg, dom := args[0], args[1], args[2], args[3]
+		if err := a.ApplicationEPGDomainPhysicalAdd(tenant, ap, epg, dom, aci.ImmediacyImmediate, aci.ImmediacyImmediate); err != nil {
+			log.Printf("FAILURE: dom-add error: %v", err)
+			return
+		}
+		log.Printf("SUCCESS: dom-add: %s", dom)
+	case "dom-del":
+		if len(args) < 4 {
+			log.Fatalf("usage: %s dom-del tenant application-profile epg physical-domain", os.Args[0])
+		}
+		tenant, ap, epg, dom := args[0], args[1], args[2], args[3]
+		if err := a.ApplicationEPGDomainPhysicalDel(tenant, ap, epg, dom); err != nil {
+			log.Printf("FAILURE: dom-del error: %v", err)
+			return
+		}
+		log.Printf("SUCCESS: dom-del: %s", dom)
+	case "path-add":
+		if len(args) < 7 {
+			log.Fatalf("usage: %s path-add tenant application-profile epg pod node port encap", os.Args[0])
+		}
+		tenant, ap, epg := args[0], args[1], args[2]
+		path := aci.PathPort(args[3], args[4], args[5])
+		if err := a.ApplicationEPGStaticPathAdd(tenant, ap, epg, path, args[6], aci.StaticPathModeTrunk, aci.ImmediacyImmediate); err != nil {
+			log.Printf("FAILURE: path-add error: %v", err)
+			return
+		}
+		log.Printf("SUCCESS: path-add: %s", path)
+	case "path-del":
+		if len(args) < 6 {
+			log.Fatalf("usage: %s path-del tenant application-profile epg pod node port", os.Args[0])
+		}
+		tenant, ap, epg := args[0], args[1], args[2]
+		path := aci.PathPort(args[3], args[4], args[5])
+		if err := a.ApplicationEPGStaticPathDel(tenant, ap, epg, path); err != nil {
+			log.Printf("FAILURE: path-del error: %v", err)
+			return
+		}
+		log.Printf("SUCCESS: path-del: %s", path)
+	case "list":
+	default:
+		log.Printf("unknown command: %s", cmd)
+	}
+}
+
+func login(debug bool) (*aci.Client, error) {
+
+	a, errNew := aci.New(aci.ClientOptions{Debug: debug})
+	if errNew != nil {
+		return nil, fmt.Errorf("login new client error: %v", errNew)
+	}
+
+	errLogin := a.Login()
+	if errLogin != nil {
+		return nil, fmt.Errorf("login error: %v", errLogin)
+	}
+
+	return a, nil
+}
+
+func logout(a *aci.Client) {
+	errLogout := a.Logout()
+	if errLogout != nil {
+		log.Printf("logout error: %v", errLogout)
+		return
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"github.com/udhos/acigo/aci"
 )
@@ -13,7 +14,7 @@ func main() {
 	debug := os.Getenv("DEBUG") != ""
 
 	if len(os.Args) < 2 {
-		log.Fatalf("usage: %s add|del|list|run args", os.Args[0])
+		log.Fatalf("usage: %s add|del|list|run|runwait|onetime|jobs args", os.Args[0])
 	}
 
 	a, errLogin := login(debug)
@@ -104,12 +105,64 @@ func execute(a *aci.Client, cmd string, args []string) {
 			return
 		}
 		log.Printf("SUCCESS: run: %s", config)
+	case "runwait":
+		if len(args) < 1 {
+			log.Fatalf("usage: %s runwait config", os.Args[0])
+		}
+		config := args[0]
+		job, errRun := a.ExportConfigurationRunWait(config, 10*time.Minute)
+		if errRun != nil {
+			log.Printf("FAILURE: runwait error: %v", errRun)
+			return
+		}
+		showJob("runwait", config, job)
+	case "onetime":
+		if len(args) < 2 {
+			log.Fatalf("usage: %s onetime config json|xml [target-dn] [remote-location]", os.Args[0])
+		}
+		config := args[0]
+		format := args[1]
+		var targetDn, remoteLocation string
+		if len(args) > 2 {
+			targetDn = args[2]
+		}
+		if len(args) > 3 {
+			remoteLocation = args[3]
+		}
+		snapshot := remoteLocation == ""
+		job, errRun := a.ExportConfigurationOneTimeRunWait(config, format, targetDn, remoteLocation, snapshot, "", 10*time.Minute)
+		if errRun != nil {
+			log.Printf("FAILURE: onetime error: %v", errRun)
+			return
+		}
+		showJob("onetime", config, job)
+	case "jobs":
+		if len(args) < 1 {
+			log.Fatalf("usage: %s jobs config", os.Args[0])
+		}
+		config := args[0]
+		jobs, errJobs := a.ExportConfigurationJobList(config)
+		if errJobs != nil {
+			log.Printf("FAILURE: jobs error: %v", errJobs)
+			return
+		}
+		for _, j := range jobs {
+			log.Printf("FOUND job: dn=%s operSt=%s executeTime=%s fileName=%s fileSize=%s details=%s", j.Dn, j.OperSt, j.ExecuteTime, j.FileName, j.FileSize, j.Details)
+		}
 	case "list":
 	default:
 		log.Printf("unknown command: %s", cmd)
 	}
 }
 
+func showJob(cmd, config string, job aci.ConfigJob) {
+	if !job.Success() {
+		log.Printf("FAILURE: %s: %s job=%s operSt=%s details=%s", cmd, config, job.Dn, job.OperSt, job.Details)
+		return
+	}
+	log.Printf("SUCCESS: %s: %s job=%s fileName=%s fileSize=%s details=%s", cmd, config, job.Dn, job.FileName, job.FileSize, job.Details)
+}
+
 func login(debug bool) (*aci.Client, error) {
 
 	a, errNew := aci.New(aci.ClientOptions{Debug: debug})
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/udhos/acigo/aci"
+)
+
+func main() {
+
+	debug := os.Getenv("DEBUG") != ""
+
+	if len(os.Args) < 2 {
+		log.Fatalf("usage: %s list|ack|unack args", os.Args[0])
+	}
+
+	a, errLogin := login(debug)
+	if errLogin != nil {
+		log.Printf("exiting: %v", errLogin)
+		return
+	}
+
+	defer logout(a)
+
+	execute(a, os.Args[1], os.Args[2:])
+}
+
+func execute(a *aci.Client, cmd string, args []string) {
+	switch cmd {
+	case "list":
+		// list [severity1,severity2,...] [dn] [code]
+		var f aci.FaultFilter
+		if len(args) > 0 && args[0] != "" {
+			f.Severity = strings.Split(args[0], ",")
+		}
+		if len(args) > 1 {
+			f.Dn = args[1]
+		}
+		if len(args) > 2 {
+			f.Code = args[2]
+		}
+		faults, errQuery := a.FaultQuery(f)
+		if errQuery != nil {
+			log.Printf("FAILURE: list error: %v", errQuery)
+			return
+		}
+		for _, f := range faults {
+			log.Printf("FOUND fault: severity=%s code=%s lc=%s ack=%s created=%s dn=%s cause=%s descr=%s", f.Severity, f.Code, f.Lc, f.Ack, f.Created, f.Dn, f.Cause, f.Descr)
+		}
+		log.Printf("faults found: %d", len(faults))
+	case "ack", "unack":
+		if len(args) < 1 {
+			log.Fatalf("usage: %s %s fault-dn", os.Args[0], cmd)
+		}
+		dn := args[0]
+		errAck := a.FaultAck(dn, cmd == "ack")
+		if errAck != nil {
+			log.Printf("FAILURE: %s error: %v", cmd, errAck)
+			return
+		}
+		log.Printf("SUCCESS: %s: %s", cmd, dn)
+	default:
+		log.Printf("unknown command: %s", cmd)
+	}
+}
+
+func login(debug bool) (*aci.Client, error) {
+
+	a, errNew := aci.New(aci.ClientOptions{Debug: debug})
+	if errNew != nil {
+		return nil, fmt.Errorf("login new client error: %v", errNew)
+	}
+
+	errLogin := a.Login()
+	if errLogin != nil {
+		return nil, fmt.Errorf("login error: %v", errLogin)
+	}
+
+	return a, nil
+}
+
+func logout(a *aci.Client) {
+	errLogout := a.Logout()
+	if errLogout != nil {
+		log.Printf("logout error: %v", errLogout)
+		return
+	}
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/udhos/acigo/aci"
+)
+
+func main() {
+
+	debug := os.Getenv("DEBUG") != ""
+
+	if len(os.Args) < 2 {
+		log.Fatalf("usage: %s get|analyze|check args", os.Args[0])
+	}
+
+	cmd := os.Args[1]
+	args := os.Args[2:]
+
+	if cmd == "check" {
+		// offline: no APIC login required
+		check(args)
+		return
+	}
+
+	a, errLogin := login(debug)
+	if errLogin != nil {
+		log.Printf("exiting: %v", errLogin)
+		return
+	}
+
+	defer logout(a)
+
+	execute(a, cmd, args)
+}
+
+func execute(a *aci.Client, cmd string, args []string) {
+	switch cmd {
+	case "get":
+		if len(args) < 6 {
+			log.Fatalf("usage: %s get src-tenant src-ap src-epg dst-tenant dst-ap dst-epg > policy.json", os.Args[0])
+		}
+		p, errGet := a.FlowPolicyGet(args[0], args[1], args[2], args[3], args[4], args[5])
+		if errGet != nil {
+			log.Printf("FAILURE: get error: %v", errGet)
+			return
+		}
+		buf, errJSON := json.MarshalIndent(p, "", "  ")
+		if errJSON != nil {
+			log.Printf("FAILURE: get json error: %v", errJSON)
+			return
+		}
+		fmt.Println(string(buf))
+		log.Printf("SUCCESS: get: %s/%s/%s => %s/%s/%s", args[0], args[1], args[2], args[3], args[4], args[5])
+	case "analyze":
+		if len(args) < 9 {
+			log.Fatalf("usage: %s analyze src-tenant src-ap src-epg dst-tenant dst-ap dst-epg protocol src-port dst-port [est]", os.Args[0])
+		}
+		p, errGet := a.FlowPolicyGet(args[0], args[1], args[2], args[3], args[4], args[5])
+		if errGet != nil {
+			log.Printf("FAILURE: analyze error: %v", errGet)
+			return
+		}
+		report(p, flow(args[6:]))
+	default:
+		log.Printf("unknown command: %s", cmd)
+	}
+}
+
+func check(args []string) {
+	if len(args) < 4 {
+		log.Fatalf("usage: %s check policy.json protocol src-port dst-port [est]", os.Args[0])
+	}
+	buf, errRead := ioutil.ReadFile(args[0])
+	if errRead != nil {
+		log.Printf("FAILURE: check read error: %v", errRead)
+		return
+	}
+	var p aci.FlowPolicy
+	if errJSON := json.Unmarshal(buf, &p); errJSON != nil {
+		log.Printf("FAILURE: check json error: %v", errJSON)
+		return
+	}
+	report(p, flow(args[1:]))
+}
+
+// flow: protocol src-port dst-port [est]
+func flow(args []string) aci.Flow {
+	srcPort, errSrc := strconv.Atoi(args[1])
+	if errSrc != nil {
+		log.Fatalf("bad src-port: %s: %v", args[1], errSrc)
+	}
+	dstPort, errDst := strconv.Atoi(args[2])
+	if errDst != nil {
+		log.Fatalf("bad dst-port: %s: %v", args[2], errDst)
+	}
+	established := len(args) > 3 && args[3] == "est"
+	return aci.Flow{Protocol: args[0], SrcPort: srcPort, DstPort: dstPort, Established: established}
+}
+
+func report(p aci.FlowPolicy, f aci.Flow) {
+	v := p.Analyze(f)
+	if v.Allowed {
+		log.Printf("ALLOWED: %s => %s %s: %s", p.Src.Name, p.Dst.Name, f, v.Reason)
+		return
+	}
+	log.Printf("DENIED: %s => %s %s: %s", p.Src.Name, p.Dst.Name, f, v.Reason)
+}
+
+func login(debug bool) (*aci.Client, error) {
+
+	a, errNew := aci.New(aci.ClientOptions{Debug: debug})
+	if errNew != nil {
+		return nil, fmt.Errorf("login new client error: %v", errNew)
+	}
+
+	errLogin := a.Login()
+	if errLogin != nil {
+		return nil, fmt.Errorf("login error: %v", errLogin)
+	}
+
+	return a, nil
+}
+
+func logout(a *aci.Client) {
+	errLogout := a.Logout()
+	if errLogout != nil {
+		log.Printf("logout error: %v", errLogout)
+		return
+	}
+}
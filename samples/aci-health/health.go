@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/udhos/acigo/aci"
+)
+
+func main() {
+
+	debug := os.Getenv("DEBUG") != ""
+
+	if len(os.Args) < 2 {
+		log.Fatalf("usage: %s fabric|pods|nodes|tenants|tenant|epg|dn args", os.Args[0])
+	}
+
+	a, errLogin := login(debug)
+	if errLogin != nil {
+		log.Printf("exiting: %v", errLogin)
+		return
+	}
+
+	defer logout(a)
+
+	execute(a, os.Args[1], os.Args[2:])
+}
+
+func execute(a *aci.Client, cmd string, args []string) {
+	switch cmd {
+	case "fabric":
+		h, err := a.HealthFabric()
+		if err != nil {
+			log.Printf("FAILURE: fabric error: %v", err)
+			return
+		}
+		show(h)
+	case "pods":
+		list, err := a.HealthPodList()
+		if err != nil {
+			log.Printf("FAILURE: pods error: %v", err)
+			return
+		}
+		showList(list)
+	case "nodes":
+		list, err := a.HealthNodeList()
+		if err != nil {
+			log.Printf("FAILURE: nodes error: %v", err)
+			return
+		}
+		showList(list)
+	case "tenants":
+		list, err := a.HealthTenantList()
+		if err != nil {
+			log.Printf("FAILURE: tenants error: %v", err)
+			return
+		}
+		showList(list)
+	case "tenant":
+		if len(args) < 1 {
+			log.Fatalf("usage: %s tenant tenant", os.Args[0])
+		}
+		h, err := a.HealthTenant(args[0])
+		if err != nil {
+			log.Printf("FAILURE: tenant error: %v", err)
+			return
+		}
+		show(h)
+	case "epg":
+		if len(args) < 3 {
+			log.Fatalf("usage: %s epg tenant application-profile epg", os.Args[0])
+		}
+		h, err := a.HealthEPG(args[0], args[1], args[2])
+		if err != nil {
+			log.Printf("FAILURE: epg error: %v", err)
+			return
+		}
+		show(h)
+	case "dn":
+		if len(args) < 1 {
+			log.Fatalf("usage: %s dn dn", os.Args[0])
+		}
+		h, children, err := a.HealthWithChildren(args[0])
+		if err != nil {
+			log.Printf("FAILURE: dn error: %v", err)
+			return
+		}
+		show(h)
+		showList(children)
+	default:
+		log.Printf("unknown command: %s", cmd)
+	}
+}
+
+func show(h aci.HealthScore) {
+	log.Printf("FOUND health: cur=%d prev=%d maxSev=%s dn=%s", h.Cur, h.Prev, h.MaxSev, h.Dn)
+}
+
+func showList(list []aci.HealthScore) {
+	for _, h := range list {
+		show(h)
+	}
+}
+
+func login(debug bool) (*aci.Client, error) {
+
+	a, errNew := aci.New(aci.ClientOptions{Debug: debug})
+	if errNew != nil {
+		return nil, fmt.Errorf("login new client error: %v", errNew)
+	}
+
+	errLogin := a.Login()
+	if errLogin != nil {
+		return nil, fmt.Errorf("login error: %v", errLogin)
+	}
+
+	return a, nil
+}
+
+func logout(a *aci.Client) {
+	errLogout := a.Logout()
+	if errLogout != nil {
+		log.Printf("logout error: %v", errLogout)
+		return
+	}
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/udhos/acigo/aci"
+)
+
+func main() {
+
+	debug := os.Getenv("DEBUG") != ""
+
+	if len(os.Args) < 2 {
+		log.Fatalf("usage: %s add|del|list|run|jobs args", os.Args[0])
+	}
+
+	a, errLogin := login(debug)
+	if errLogin != nil {
+		log.Printf("exiting: %v", errLogin)
+		return
+	}
+
+	defer logout(a)
+
+	execute(a, os.Args[1], os.Args[2:])
+
+	// display existing
+
+	list, errList := a.ImportConfigurationList()
+	if errList != nil {
+		log.Printf("could not list: %v", errList)
+		return
+	}
+
+	for _, t := range list {
+		config := t["name"]
+		dn := t["dn"]
+		fileName := t["fileName"]
+		importType := t["importType"]
+		importMode := t["importMode"]
+		descr := t["descr"]
+
+		log.Printf("FOUND import config: config=%s dn=%s fileName=%s importType=%s importMode=%s descr=%s", config, dn, fileName, importType, importMode, descr)
+
+		conf, isStr := config.(string)
+		if !isStr {
+			log.Printf("  config=%s not a string", config)
+			continue
+		}
+
+		loc, errLoc := a.ImportConfigurationRemoteLocationGet(conf)
+		if errLoc == nil {
+			name := loc["tnFileRemotePathName"]
+			log.Printf("  config=%s remote location: name=[%s]", conf, name)
+		}
+	}
+}
+
+func execute(a *aci.Client, cmd string, args []string) {
+	switch cmd {
+	case "add":
+		if len(args) < 5 {
+			log.Fatalf("usage: %s add config file-name remote-location merge|replace best-effort|atomic [descr]", os.Args[0])
+		}
+		config := args[0]
+		fileName := args[1]
+		remoteLocation := args[2]
+		importType := args[3]
+		importMode := args[4]
+		var descr string
+		if len(args) > 5 {
+			descr = args[5]
+		}
+		errAdd := a.ImportConfigurationAdd(config, fileName, remoteLocation, importType, importMode, descr)
+		if errAdd != nil {
+			log.Printf("FAILURE: add error: %v", errAdd)
+			return
+		}
+		log.Printf("SUCCESS: add: %s %s %s %s %s %s", config, fileName, remoteLocation, importType, importMode, descr)
+	case "del":
+		if len(args) < 1 {
+			log.Fatalf("usage: %s del config", os.Args[0])
+		}
+		config := args[0]
+		errDel := a.ImportConfigurationDel(config)
+		if errDel != nil {
+			log.Printf("FAILURE: del error: %v", errDel)
+			return
+		}
+		log.Printf("SUCCESS: del: %s", config)
+	case "run":
+		if len(args) < 1 {
+			log.Fatalf("usage: %s run config", os.Args[0])
+		}
+		config := args[0]
+		job, errRun := a.ImportConfigurationRunWait(config, 10*time.Minute)
+		if errRun != nil {
+			log.Printf("FAILURE: run error: %v", errRun)
+			return
+		}
+		if !job.Success() {
+			log.Printf("FAILURE: run: %s job=%s operSt=%s details=%s", config, job.Dn, job.OperSt, job.Details)
+			return
+		}
+		log.Printf("SUCCESS: run: %s job=%s operSt=%s", config, job.Dn, job.OperSt)
+	case "jobs":
+		if len(args) < 1 {
+			log.Fatalf("usage: %s jobs config", os.Args[0])
+		}
+		config := args[0]
+		jobs, errJobs := a.ImportConfigurationJobList(config)
+		if errJobs != nil {
+			log.Printf("FAILURE: jobs error: %v", errJobs)
+			return
+		}
+		for _, j := range jobs {
+			log.Printf("FOUND job: dn=%s operSt=%s executeTime=%s details=%s", j.Dn, j.OperSt, j.ExecuteTime, j.Details)
+		}
+	case "list":
+	default:
+		log.Printf("unknown command: %s", cmd)
+	}
+}
+
+func login(debug bool) (*aci.Client, error) {
+
+	a, errNew := aci.New(aci.ClientOptions{Debug: debug})
+	if errNew != nil {
+		return nil, fmt.Errorf("login new client error: %v", errNew)
+	}
+
+	errLogin := a.Login()
+	if errLogin != nil {
+		return nil, fmt.Errorf("login error: %v", errLogin)
+	}
+
+	return a, nil
+}
+
+func logout(a *aci.Client) {
+	errLogout := a.Logout()
+	if errLogout != nil {
+		log.Printf("logout error: %v", errLogout)
+		return
+	}
+}
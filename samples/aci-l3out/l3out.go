@@ -13,7 +13,7 @@ func main() {
 	debug := os.Getenv("DEBUG") != ""
 
 	if len(os.Args) < 3 {
-		log.Fatalf("usage: %s add|del|list|vrf-set|vrf-get|dom-set|dom-get args", os.Args[0])
+		log.Fatalf("usage: %s add|del|list|vrf-set|vrf-get|dom-set|dom-get|epg-add|epg-del|subnet-add|subnet-del args", os.Args[0])
 	}
 
 	a, errLogin := login(debug)
@@ -58,6 +58,23 @@ func main() {
 		if errDomGet == nil {
 			log.Printf("  external routed network %s domain=[%s]", out, dom)
 		}
+
+		epgs, errEpgs := a.L3ExtOutEPGList(tenant, out)
+		if errEpgs != nil {
+			log.Printf("  could not list external EPGs: %v", errEpgs)
+			continue
+		}
+		for _, e := range epgs {
+			epg, isStr := e["name"].(string)
+			if !isStr {
+				continue
+			}
+			log.Printf("  external EPG: %s", epg)
+			subnets, _ := a.L3ExtOutEPGSubnetList(tenant, out, epg)
+			for _, s := range subnets {
+				log.Printf("    subnet: %s scope=%v", s.IP, s.Scope)
+			}
+		}
 	}
 }
 
@@ -142,6 +159,46 @@ func execute(a *aci.Client, cmd string, args []string) {
 			return
 		}
 		log.Printf("SUCCESS: dom-get: tenant=%s out=%s: => dom=%s", tenant, out, dom)
+	case "epg-add":
+		if len(args) < 3 {
+			log.Fatalf("usage: %s epg-add tenant out epg", os.Args[0])
+		}
+		tenant, out, epg := args[0], args[1], args[2]
+		if err := a.L3ExtOutEPGAdd(tenant, out, epg, ""); err != nil {
+			log.Printf("FAILURE: epg-add error: %v", err)
+			return
+		}
+		log.Printf("SUCCESS: epg-add: tenant=%s out=%s epg=%s", tenant, out, epg)
+	case "epg-del":
+		if len(args) < 3 {
+			log.Fatalf("usage: %s epg-del tenant out epg", os.Args[0])
+		}
+		tenant, out, epg := args[0], args[1], args[2]
+		if err := a.L3ExtOutEPGDel(tenant, out, epg); err != nil {
+			log.Printf("FAILURE: epg-del error: %v", err)
+			return
+		}
+		log.Printf("SUCCESS: epg-del: tenant=%s out=%s epg=%s", tenant, out, epg)
+	case "subnet-add":
+		if len(args) < 4 {
+			log.Fatalf("usage: %s subnet-add tenant out epg ip", os.Args[0])
+		}
+		tenant, out, epg, ip := args[0], args[1], args[2], args[3]
+		if err := a.L3ExtOutEPGSubnetAdd(tenant, out, epg, ip, []string{aci.L3ExtSubnetScopeImportSecurity}, ""); err != nil {
+			log.Printf("FAILURE: subnet-add error: %v", err)
+			return
+		}
+		log.Printf("SUCCESS: subnet-add: tenant=%s out=%s epg=%s ip=%s", tenant, out, epg, ip)
+	case "subnet-del":
+		if len(args) < 4 {
+			log.Fatalf("usage: %s subnet-del tenant out epg ip", os.Args[0])
+		}
+		tenant, out, epg, ip := args[0], args[1], args[2], args[3]
+		if err := a.L3ExtOutEPGSubnetDel(tenant, out, epg, ip); err != nil {
+			log.Printf("FAILURE: subnet-del error: %v", err)
+			return
+		}
+		log.Printf("SUCCESS: subnet-del: tenant=%s out=%s epg=%s ip=%s", tenant, out, epg, ip)
 	default:
 		log.Printf("unknown command: %s", cmd)
 	}
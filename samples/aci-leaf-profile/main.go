@@ -0,0 +1,160 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/udhos/acigo/aci"
+)
+
+func main() {
+
+	debug := os.Getenv("DEBUG") != ""
+
+	if len(os.Args) < 2 {
+		log.Fatalf("usage: %s provision|unprovision|list args", os.Args[0])
+	}
+
+	a, errLogin := login(debug)
+	if errLogin != nil {
+		log.Printf("exiting: %v", errLogin)
+		return
+	}
+
+	defer logout(a)
+
+	execute(a, os.Args[1], os.Args[2:])
+
+	// display existing
+
+	switches, errList := a.LeafSwitchProfileList()
+	if errList != nil {
+		log.Printf("could not list switch profiles: %v", errList)
+		return
+	}
+
+	for _, s := range switches {
+		name := s["name"]
+		log.Printf("FOUND leaf switch profile: name=%s dn=%s", name, s["dn"])
+
+		profile, isStr := name.(string)
+		if !isStr {
+			continue
+		}
+
+		ifProfiles, errIf := a.LeafSwitchInterfaceProfileList(profile)
+		if errIf != nil {
+			log.Printf("  could not list interface profiles: %v", errIf)
+		}
+		for _, i := range ifProfiles {
+			log.Printf("  interface profile: %s", i["tDn"])
+		}
+	}
+
+	interfaces, errIfList := a.LeafInterfaceProfileList()
+	if errIfList != nil {
+		log.Printf("could not list interface profiles: %v", errIfList)
+		return
+	}
+
+	for _, i := range interfaces {
+		name := i["name"]
+		log.Printf("FOUND leaf interface profile: name=%s dn=%s", name, i["dn"])
+
+		profile, isStr := name.(string)
+		if !isStr {
+			continue
+		}
+
+		selectors, errSel := a.LeafInterfaceSelectorList(profile)
+		if errSel != nil {
+			log.Printf("  could not list selectors: %v", errSel)
+		}
+		for _, sel := range selectors {
+			selector, isStr := sel["name"].(string)
+			if !isStr {
+				continue
+			}
+			group, _ := a.LeafInterfaceSelectorGroupGet(profile, selector)
+			log.Printf("  selector: %s group=%s", selector, group)
+			blocks, _ := a.LeafInterfacePortBlockList(profile, selector)
+			for _, b := range blocks {
+				log.Printf("    port block: %s %s/%s-%s/%s", b["name"], b["fromCard"], b["fromPort"], b["toCard"], b["toPort"])
+			}
+		}
+	}
+}
+
+// provision creates a leaf switch profile with a leaf selector and a node block,
+// and a leaf interface profile with a port selector and a port block pointing to the policy group.
+func execute(a *aci.Client, cmd string, args []string) {
+	switch cmd {
+	case "provision":
+		if len(args) < 5 {
+			log.Fatalf("usage: %s provision name node-from node-to ports policy-group", os.Args[0])
+		}
+		name := args[0]
+		from := args[1]
+		to := args[2]
+		ports := args[3]
+		group := args[4]
+
+		steps := []func() error{
+			func() error { return a.LeafSwitchProfileAdd(name, "") },
+			func() error { return a.LeafSwitchSelectorAdd(name, name, "") },
+			func() error { return a.LeafSwitchNodeBlockAdd(name, name, name, from, to) },
+			func() error { return a.LeafInterfaceProfileAdd(name, "") },
+			func() error { return a.LeafInterfaceSelectorAdd(name, name, group, "") },
+			func() error { return a.LeafInterfacePortBlockAdd(name, name, name, ports) },
+			func() error { return a.LeafSwitchInterfaceProfileAdd(name, name) },
+		}
+		for i, s := range steps {
+			if err := s(); err != nil {
+				log.Printf("FAILURE: provision step %d error: %v", i, err)
+				return
+			}
+		}
+		log.Printf("SUCCESS: provision: %s nodes=%s-%s ports=%s group=%s", name, from, to, ports, group)
+	case "unprovision":
+		if len(args) < 1 {
+			log.Fatalf("usage: %s unprovision name", os.Args[0])
+		}
+		name := args[0]
+		if err := a.LeafSwitchProfileDel(name); err != nil {
+			log.Printf("FAILURE: unprovision switch profile error: %v", err)
+			return
+		}
+		if err := a.LeafInterfaceProfileDel(name); err != nil {
+			log.Printf("FAILURE: unprovision interface profile error: %v", err)
+			return
+		}
+		log.Printf("SUCCESS: unprovision: %s", name)
+	case "list":
+	default:
+		log.Printf("unknown command: %s", cmd)
+	}
+}
+
+func login(debug bool) (*aci.Client, error) {
+
+	a, errNew := aci.New(aci.ClientOptions{Debug: debug})
+	if errNew != nil {
+		return nil, fmt.Errorf("login new client error: %v", errNew)
+	}
+
+	errLogin := a.Login()
+	if errLogin != nil {
+		return nil, fmt.Errorf("login error: %v", errLogin)
+	}
+
+	return a, nil
+}
+
+func logout(a *aci.Client) {
+	errLogout := a.Logout()
+	if errLogout != nil {
+		log.Printf("logout error: %v", errLogout)
+		return
+	}
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/udhos/acigo/aci"
+)
+
+func main() {
+
+	debug := os.Getenv("DEBUG") != ""
+
+	if len(os.Args) < 2 {
+		log.Fatalf("usage: %s add|del|list|recurr-add|recurr-del|once-add|once-del args", os.Args[0])
+	}
+
+	a, errLogin := login(debug)
+	if errLogin != nil {
+		log.Printf("exiting: %v", errLogin)
+		return
+	}
+
+	defer logout(a)
+
+	execute(a, os.Args[1], os.Args[2:])
+
+	// display existing
+
+	list, errList := a.SchedulerList()
+	if errList != nil {
+		log.Printf("could not list: %v", errList)
+		return
+	}
+
+	for _, t := range list {
+		name := t["name"]
+		dn := t["dn"]
+		descr := t["descr"]
+
+		log.Printf("FOUND scheduler: name=%s dn=%s descr=%s", name, dn, descr)
+
+		sched, isStr := name.(string)
+		if !isStr {
+			log.Printf("  scheduler=%s not a string", name)
+			continue
+		}
+
+		recurr, errRecurr := a.SchedulerRecurringWindowList(sched)
+		if errRecurr != nil {
+			log.Printf("  could not list recurring windows: %v", errRecurr)
+		}
+		for _, w := range recurr {
+			log.Printf("  recurring window: name=%s day=%s hour=%s minute=%s concurCap=%s", w["name"], w["day"], w["hour"], w["minute"], w["concurCap"])
+		}
+
+		once, errOnce := a.SchedulerOneTimeWindowList(sched)
+		if errOnce != nil {
+			log.Printf("  could not list one-time windows: %v", errOnce)
+		}
+		for _, w := range once {
+			log.Printf("  one-time window: name=%s date=%s concurCap=%s", w["name"], w["date"], w["concurCap"])
+		}
+
+		refs, errRefs := a.SchedulerReferenceList(sched)
+		if errRefs != nil {
+			log.Printf("  could not list references: %v", errRefs)
+		}
+		for _, r := range refs {
+			log.Printf("  referenced by: %s", r)
+		}
+	}
+}
+
+func execute(a *aci.Client, cmd string, args []string) {
+	switch cmd {
+	case "add":
+		if len(args) < 1 {
+			log.Fatalf("usage: %s add scheduler [descr]", os.Args[0])
+		}
+		scheduler := args[0]
+		var descr string
+		if len(args) > 1 {
+			descr = args[1]
+		}
+		errAdd := a.SchedulerAdd(scheduler, descr)
+		if errAdd != nil {
+			log.Printf("FAILURE: add error: %v", errAdd)
+			return
+		}
+		log.Printf("SUCCESS: add: %s %s", scheduler, descr)
+	case "del":
+		if len(args) < 1 {
+			log.Fatalf("usage: %s del scheduler", os.Args[0])
+		}
+		scheduler := args[0]
+		errDel := a.SchedulerDel(scheduler)
+		if errDel != nil {
+			log.Printf("FAILURE: del error: %v", errDel)
+			return
+		}
+		log.Printf("SUCCESS: del: %s", scheduler)
+	case "recurr-add":
+		if len(args) < 5 {
+			log.Fatalf("usage: %s recurr-add scheduler window day hour minute [concurCap]", os.Args[0])
+		}
+		var concurCap string
+		if len(args) > 5 {
+			concurCap = args[5]
+		}
+		errAdd := a.SchedulerRecurringWindowAdd(args[0], args[1], args[2], args[3], args[4], concurCap)
+		if errAdd != nil {
+			log.Printf("FAILURE: recurr-add error: %v", errAdd)
+			return
+		}
+		log.Printf("SUCCESS: recurr-add: %s %s", args[0], args[1])
+	case "recurr-del":
+		if len(args) < 2 {
+			log.Fatalf("usage: %s recurr-del scheduler window", os.Args[0])
+		}
+		errDel := a.SchedulerRecurringWindowDel(args[0], args[1])
+		if errDel != nil {
+			log.Printf("FAILURE: recurr-del error: %v", errDel)
+			return
+		}
+		log.Printf("SUCCESS: recurr-del: %s %s", args[0], args[1])
+	case "once-add":
+		if len(args) < 3 {
+			log.Fatalf("usage: %s once-add scheduler window date [concurCap]", os.Args[0])
+		}
+		var concurCap string
+		if len(args) > 3 {
+			concurCap = args[3]
+		}
+		errAdd := a.SchedulerOneTimeWindowAdd(args[0], args[1], args[2], concurCap)
+		if errAdd != nil {
+			log.Printf("FAILURE: once-add error: %v", errAdd)
+			return
+		}
+		log.Printf("SUCCESS: once-add: %s %s", args[0], args[1])
+	case "once-del":
+		if len(args) < 2 {
+			log.Fatalf("usage: %s once-del scheduler window", os.Args[0])
+		}
+		errDel := a.SchedulerOneTimeWindowDel(args[0], args[1])
+		if errDel != nil {
+			log.Printf("FAILURE: once-del error: %v", errDel)
+			return
+		}
+		log.Printf("SUCCESS: once-del: %s %s", args[0], args[1])
+	case "list":
+	default:
+		log.Printf("unknown command: %s", cmd)
+	}
+}
+
+func login(debug bool) (*aci.Client, error) {
+
+	a, errNew := aci.New(aci.ClientOptions{Debug: debug})
+	if errNew != nil {
+		return nil, fmt.Errorf("login new client error: %v", errNew)
+	}
+
+	errLogin := a.Login()
+	if errLogin != nil {
+		return nil, fmt.Errorf("login error: %v", errLogin)
+	}
+
+	return a, nil
+}
+
+func logout(a *aci.Client) {
+	errLogout := a.Logout()
+	if errLogout != nil {
+		log.Printf("logout error: %v", errLogout)
+		return
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/udhos/acigo/aci"
+)
+
+func main() {
+
+	debug := os.Getenv("DEBUG") != ""
+
+	if len(os.Args) < 2 {
+		log.Fatalf("usage: %s create|compare|rollback|list args", os.Args[0])
+	}
+
+	a, errLogin := login(debug)
+	if errLogin != nil {
+		log.Printf("exiting: %v", errLogin)
+		return
+	}
+
+	defer logout(a)
+
+	execute(a, os.Args[1], os.Args[2:])
+
+	// display existing
+
+	list, errList := a.ConfigSnapshotList()
+	if errList != nil {
+		log.Printf("could not list: %v", errList)
+		return
+	}
+
+	for _, s := range list {
+		log.Printf("FOUND snapshot: created=%s fileName=%s dn=%s descr=%s", s.CreateTime, s.FileName, s.Dn, s.Descr)
+	}
+}
+
+func execute(a *aci.Client, cmd string, args []string) {
+	switch cmd {
+	case "create":
+		if len(args) < 1 {
+			log.Fatalf("usage: %s create export-config [descr]", os.Args[0])
+		}
+		config := args[0]
+		var descr string
+		if len(args) > 1 {
+			descr = args[1]
+		}
+		job, errCreate := a.ConfigSnapshotCreate(config, descr, 10*time.Minute)
+		if errCreate != nil {
+			log.Printf("FAILURE: create error: %v", errCreate)
+			return
+		}
+		if !job.Success() {
+			log.Printf("FAILURE: create: %s job=%s operSt=%s details=%s", config, job.Dn, job.OperSt, job.Details)
+			return
+		}
+		log.Printf("SUCCESS: create: %s fileName=%s", config, job.FileName)
+	case "compare":
+		if len(args) < 2 {
+			log.Fatalf("usage: %s compare snapshot-dn1 snapshot-dn2", os.Args[0])
+		}
+		diff, errDiff := a.ConfigSnapshotCompare(args[0], args[1])
+		if errDiff != nil {
+			log.Printf("FAILURE: compare error: %v", errDiff)
+			return
+		}
+		fmt.Println(diff)
+	case "rollback":
+		if len(args) < 2 {
+			log.Fatalf("usage: %s rollback rollback-config snapshot-file-name", os.Args[0])
+		}
+		config := args[0]
+		fileName := args[1]
+		job, errRollback := a.ConfigSnapshotRollback(config, fileName, 10*time.Minute)
+		if errRollback != nil {
+			log.Printf("FAILURE: rollback error: %v", errRollback)
+			return
+		}
+		if !job.Success() {
+			log.Printf("FAILURE: rollback: %s job=%s operSt=%s details=%s", fileName, job.Dn, job.OperSt, job.Details)
+			return
+		}
+		log.Printf("SUCCESS: rollback: %s", fileName)
+	case "list":
+	default:
+		log.Printf("unknown command: %s", cmd)
+	}
+}
+
+func login(debug bool) (*aci.Client, error) {
+
+	a, errNew := aci.New(aci.ClientOptions{Debug: debug})
+	if errNew != nil {
+		return nil, fmt.Errorf("login new client error: %v", errNew)
+	}
+
+	errLogin := a.Login()
+	if errLogin != nil {
+		return nil, fmt.Errorf("login error: %v", errLogin)
+	}
+
+	return a, nil
+}
+
+func logout(a *aci.Client) {
+	errLogout := a.Logout()
+	if errLogout != nil {
+		log.Printf("logout error: %v", errLogout)
+		return
+	}
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/udhos/acigo/aci"
+)
+
+func main() {
+
+	debug := os.Getenv("DEBUG") != ""
+
+	if len(os.Args) < 2 {
+		log.Fatalf("usage: %s pair-add|pair-del|group-add|group-del|list args", os.Args[0])
+	}
+
+	a, errLogin := login(debug)
+	if errLogin != nil {
+		log.Printf("exiting: %v", errLogin)
+		return
+	}
+
+	defer logout(a)
+
+	execute(a, os.Args[1], os.Args[2:])
+
+	// display existing
+
+	pairs, errPairs := a.VpcProtectionGroupList()
+	if errPairs != nil {
+		log.Printf("could not list vPC protection groups: %v", errPairs)
+		return
+	}
+
+	for _, p := range pairs {
+		log.Printf("FOUND vPC protection group: name=%s id=%s nodes=%v", p.Name, p.ID, p.Nodes)
+	}
+
+	groups, errGroups := a.LeafInterfaceBundleGroupList()
+	if errGroups != nil {
+		log.Printf("could not list bundle policy groups: %v", errGroups)
+		return
+	}
+
+	for _, g := range groups {
+		name, isStr := g["name"].(string)
+		if !isStr {
+			continue
+		}
+		aep, _ := a.LeafInterfaceBundleGroupEntityGet(name)
+		lacp, _ := a.LeafInterfaceBundleGroupLacpGet(name)
+		log.Printf("FOUND bundle policy group: name=%s lagT=%s aaep=%s lacp=%s", name, g["lagT"], aep, lacp)
+	}
+}
+
+func execute(a *aci.Client, cmd string, args []string) {
+	switch cmd {
+	case "pair-add":
+		if len(args) < 5 {
+			log.Fatalf("usage: %s pair-add name id pod node1 node2", os.Args[0])
+		}
+		if err := a.VpcProtectionGroupAdd(args[0], args[1], args[2], args[3], args[4]); err != nil {
+			log.Printf("FAILURE: pair-add error: %v", err)
+			return
+		}
+		log.Printf("SUCCESS: pair-add: %s", args[0])
+	case "pair-del":
+		if len(args) < 1 {
+			log.Fatalf("usage: %s pair-del name", os.Args[0])
+		}
+		if err := a.VpcProtectionGroupDel(args[0]); err != nil {
+			log.Printf("FAILURE: pair-del error: %v", err)
+			return
+		}
+		log.Printf("SUCCESS: pair-del: %s", args[0])
+	case "group-add":
+		if len(args) < 3 {
+			log.Fatalf("usage: %s group-add name aaep lacp-policy", os.Args[0])
+		}
+		name := args[0]
+		steps := []func() error{
+			func() error { return a.LeafInterfaceBundleGroupAdd(name, aci.BundleTypeVPC, "") },
+			func() error { return a.LeafInterfaceBundleGroupEntitySet(name, args[1]) },
+			func() error { return a.LeafInterfaceBundleGroupLacpSet(name, args[2]) },
+		}
+		for i, s := range steps {
+			if err := s(); err != nil {
+				log.Printf("FAILURE: group-add step %d error: %v", i, err)
+				return
+			}
+		}
+		log.Printf("SUCCESS: group-add: %s", name)
+	case "group-del":
+		if len(args) < 1 {
+			log.Fatalf("usage: %s group-del name", os.Args[0])
+		}
+		if err := a.LeafInterfaceBundleGroupDel(args[0]); err != nil {
+			log.Printf("FAILURE: group-del error: %v", err)
+			return
+		}
+		log.Printf("SUCCESS: group-del: %s", args[0])
+	case "list":
+	default:
+		log.Printf("unknown command: %s", cmd)
+	}
+}
+
+func login(debug bool) (*aci.Client, error) {
+
+	a, errNew := aci.New(aci.ClientOptions{Debug: debug})
+	if errNew != nil {
+		return nil, fmt.Errorf("login new client error: %v", errNew)
+	}
+
+	errLogin := a.Login()
+	if errLogin != nil {
+		return nil, fmt.Errorf("login error: %v", errLogin)
+	}
+
+	return a, nil
+}
+
+func logout(a *aci.Client) {
+	errLogout := a.Logout()
+	if errLogout != nil {
+		log.Printf("logout error: %v", errLogout)
+		return
+	}
+}